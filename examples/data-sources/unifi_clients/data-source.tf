@@ -0,0 +1,17 @@
+# all connected wired printers on the office network
+data "unifi_clients" "printers" {
+  network_id     = unifi_network.office.id
+  is_wired       = true
+  hostname_regex = "^(NPI|HP|BRN)"
+}
+
+# pin every printer to the IP address it currently has
+resource "unifi_user" "printer" {
+  for_each = { for c in data.unifi_clients.printers.result : c.mac => c }
+
+  mac            = each.value.mac
+  name           = each.value.hostname
+  fixed_ip       = each.value.ip
+  network_id     = each.value.network_id
+  allow_existing = true
+}
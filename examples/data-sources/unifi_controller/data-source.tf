@@ -0,0 +1,13 @@
+data "unifi_controller" "this" {
+}
+
+# Only manage zones on controllers using the zone-based firewall.
+resource "unifi_firewall_zone" "iot" {
+  count = data.unifi_controller.this.capabilities.zone_based_firewall ? 1 : 0
+
+  name = "IoT"
+}
+
+output "controller_version" {
+  value = data.unifi_controller.this.version
+}
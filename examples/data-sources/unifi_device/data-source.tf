@@ -0,0 +1,7 @@
+data "unifi_device" "core" {
+  name = "Core Switch"
+}
+
+output "core_switch_ip" {
+  value = data.unifi_device.core.ip
+}
@@ -0,0 +1,16 @@
+data "unifi_device_model" "switch" {
+  model = "US24P250"
+}
+
+# power every PoE-capable port of the switch
+resource "unifi_device" "switch" {
+  mac = "01:23:45:67:89:ab"
+
+  dynamic "port_override" {
+    for_each = toset(data.unifi_device_model.switch.poe_ports)
+    content {
+      number   = port_override.value
+      poe_mode = "auto"
+    }
+  }
+}
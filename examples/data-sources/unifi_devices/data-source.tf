@@ -0,0 +1,10 @@
+# all adopted access points connected to the core switch
+data "unifi_devices" "office_aps" {
+  type       = "uap"
+  adopted    = true
+  uplink_mac = "01:23:45:67:89:ab"
+}
+
+output "office_ap_macs" {
+  value = data.unifi_devices.office_aps.result[*].mac
+}
@@ -0,0 +1,9 @@
+data "unifi_firewall_groups" "ports" {
+  filter = {
+    purpose = "port-group"
+  }
+}
+
+output "port_group_ids" {
+  value = { for g in data.unifi_firewall_groups.ports.result : g.name => g.id }
+}
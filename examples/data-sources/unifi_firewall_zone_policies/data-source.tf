@@ -0,0 +1,11 @@
+# all disabled blocking policies
+data "unifi_firewall_zone_policies" "disabled_blocks" {
+  filter = {
+    purpose = "BLOCK"
+    enabled = false
+  }
+}
+
+output "disabled_block_policies" {
+  value = data.unifi_firewall_zone_policies.disabled_blocks.result[*].name
+}
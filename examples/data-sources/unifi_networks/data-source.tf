@@ -0,0 +1,14 @@
+# all enabled IoT VLANs
+data "unifi_networks" "iot" {
+  filter = {
+    name_regex = "^iot-"
+    purpose    = "corporate"
+    vlan_min   = 100
+    vlan_max   = 199
+    enabled    = true
+  }
+}
+
+output "iot_subnets" {
+  value = { for n in data.unifi_networks.iot.result : n.name => n.subnet }
+}
@@ -0,0 +1,9 @@
+data "unifi_port_forwards" "active" {
+  filter = {
+    enabled = true
+  }
+}
+
+output "exposed_ports" {
+  value = { for pf in data.unifi_port_forwards.active.result : pf.name => "${pf.dst_port} -> ${pf.fwd_ip}:${pf.fwd_port}" }
+}
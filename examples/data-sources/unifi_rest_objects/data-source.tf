@@ -0,0 +1,7 @@
+data "unifi_rest_objects" "traffic_rules" {
+  path = "v2/trafficrules"
+}
+
+output "traffic_rules" {
+  value = [for o in data.unifi_rest_objects.traffic_rules.objects : jsondecode(o).description]
+}
@@ -0,0 +1,7 @@
+data "unifi_sites" "branches" {
+  description_regex = "^Branch "
+}
+
+output "branch_site_names" {
+  value = data.unifi_sites.branches.result[*].name
+}
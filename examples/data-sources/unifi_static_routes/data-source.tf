@@ -0,0 +1,9 @@
+data "unifi_static_routes" "next_hop" {
+  filter = {
+    purpose = "nexthop-route"
+  }
+}
+
+output "routed_networks" {
+  value = data.unifi_static_routes.next_hop.result[*].network
+}
@@ -0,0 +1,9 @@
+data "unifi_wlans" "enabled" {
+  filter = {
+    enabled = true
+  }
+}
+
+output "ssids" {
+  value = data.unifi_wlans.enabled.result[*].name
+}
@@ -1,7 +1,11 @@
+# every site the provider's credentials can access
+data "unifi_sites" "all" {
+}
+
 resource "unifi_firewall_rule" "rule" {
-  # list of sites
-  for_each = toset(["default", "vq98kwez", "bfa2l6i7"])
-  # use the key of the list as the site value
+  # one rule per site, keyed by the site's internal name
+  for_each = toset(data.unifi_sites.all.result[*].name)
+  # use the key of the set as the site value
   site = each.key
 
   name    = "drop all"
@@ -0,0 +1,2 @@
+# import using the site and the ID of the admin
+terraform import unifi_admin.jane default:5dc28e5e9106d105bdc87217
@@ -0,0 +1,20 @@
+variable "helpdesk_password" {
+  type      = string
+  sensitive = true
+}
+
+# Read-only admin allowed to restart devices, who must change the password on first login
+resource "unifi_admin" "helpdesk" {
+  name                  = "helpdesk"
+  email                 = "helpdesk@example.com"
+  role                  = "readonly"
+  permissions           = ["API_DEVICE_RESTART"]
+  password              = var.helpdesk_password
+  requires_new_password = true
+}
+
+# Admin invited by email to set a password
+resource "unifi_admin" "jane" {
+  name  = "jane"
+  email = "jane@example.com"
+}
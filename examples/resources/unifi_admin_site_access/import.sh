@@ -0,0 +1,2 @@
+# import using the site and the ID of the admin
+terraform import unifi_admin_site_access.jane_branch branch-site:5dc28e5e9106d105bdc87217
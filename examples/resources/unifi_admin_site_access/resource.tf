@@ -0,0 +1,15 @@
+resource "unifi_site" "branch" {
+  description = "Branch"
+}
+
+resource "unifi_admin" "jane" {
+  name  = "jane"
+  email = "jane@example.com"
+}
+
+# Give the admin read-only access to the branch site too
+resource "unifi_admin_site_access" "jane_branch" {
+  site     = unifi_site.branch.name
+  admin_id = unifi_admin.jane.id
+  role     = "readonly"
+}
@@ -0,0 +1,15 @@
+resource "unifi_backup" "before_apply" {
+  path = "${path.root}/backups/controller.unf"
+
+  # Include a week of statistics and events
+  days = 7
+
+  # Take a new backup on every apply
+  triggers = {
+    applied_at = timestamp()
+  }
+}
+
+output "backup_checksum" {
+  value = unifi_backup.before_apply.sha256
+}
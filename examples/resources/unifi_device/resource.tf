@@ -63,4 +63,11 @@ resource "unifi_device" "us_24_poe" {
     op_mode             = "aggregate"
     aggregate_num_ports = 2
   }
+
+  # mirror the traffic of port 1 to port 16, e.g. for a network analyzer
+  port_override {
+    number          = 16
+    op_mode         = "mirror"
+    mirror_port_idx = 1
+  }
 }
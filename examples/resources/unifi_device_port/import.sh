@@ -0,0 +1,5 @@
+# import port 7 of a device in the provider configured site
+terraform import unifi_device_port.printer 01:23:45:67:89:ab/7
+
+# import port 7 of a device in another site
+terraform import unifi_device_port.printer bfa2l6i7/01:23:45:67:89:ab/7
@@ -0,0 +1,20 @@
+# Each port is owned by its own resource, so ports of the same switch can be
+# managed from different modules or Terraform configurations.
+resource "unifi_device_port" "printer" {
+  device_mac = "01:23:45:67:89:ab"
+  number     = 7
+
+  name            = "Printer"
+  port_profile_id = var.printer_port_profile_id
+}
+
+resource "unifi_device_port" "camera" {
+  device_mac = "01:23:45:67:89:ab"
+  number     = 8
+
+  name                  = "Camera"
+  poe_mode              = "auto"
+  forward               = "customize"
+  native_networkconf_id = var.camera_network_id
+  setting_preference    = "manual"
+}
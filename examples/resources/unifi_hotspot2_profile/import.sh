@@ -0,0 +1,5 @@
+# import from provider configured site
+terraform import unifi_hotspot2_profile.example 5dc28e5e9106d105bdc87217
+
+# import from another site
+terraform import unifi_hotspot2_profile.example another-site:5dc28e5e9106d105bdc87217
@@ -0,0 +1,32 @@
+resource "unifi_hotspot2_profile" "example" {
+  name        = "passpoint"
+  venue_group = 2
+  venue_type  = 8
+
+  venue_names = [{
+    language = "eng"
+    name     = "Example Office"
+  }]
+
+  operator_names = [{
+    name = "Example Operator"
+  }]
+
+  domain_names = ["example.com"]
+
+  nai_realms = [{
+    name       = "example.com"
+    eap_method = "eap-ttls"
+  }]
+
+  roaming_consortium_ois = [{
+    name = "OpenRoaming"
+    oi   = "5A03BA"
+  }]
+
+  plmn_ids = [{
+    name = "Example Mobile"
+    mcc  = 310
+    mnc  = 410
+  }]
+}
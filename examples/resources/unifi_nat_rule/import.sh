@@ -0,0 +1,5 @@
+# import from provider configured site
+terraform import unifi_nat_rule.masquerade 5dc28e5e9106d105bdc87217
+
+# import from another site
+terraform import unifi_nat_rule.masquerade bfa2l6i7:5dc28e5e9106d105bdc87217
@@ -0,0 +1,62 @@
+data "unifi_network" "wan" {
+  name = "Internet 1"
+}
+
+resource "unifi_network" "servers" {
+  name    = "servers"
+  purpose = "corporate"
+  subnet  = "10.0.10.0/24"
+  vlan_id = 10
+}
+
+# Masquerade traffic of the servers network leaving through the WAN
+resource "unifi_nat_rule" "servers_masquerade" {
+  description   = "Servers masquerade"
+  type          = "MASQUERADE"
+  out_interface = data.unifi_network.wan.id
+
+  source = {
+    address = unifi_network.servers.subnet
+  }
+}
+
+# Forward HTTPS from a partner network only
+resource "unifi_nat_rule" "partner_https" {
+  description     = "Partner HTTPS"
+  type            = "DNAT"
+  protocol        = "tcp"
+  in_interface    = data.unifi_network.wan.id
+  translated_ip   = "10.0.10.20"
+  translated_port = "8443"
+
+  source = {
+    address = "203.0.113.0/24"
+  }
+
+  destination = {
+    port = "443"
+  }
+}
+
+# 1:1 NAT of a public address to a server
+resource "unifi_nat_rule" "server_inbound" {
+  description   = "Server 1:1 inbound"
+  type          = "DNAT"
+  in_interface  = data.unifi_network.wan.id
+  translated_ip = "10.0.10.30"
+
+  destination = {
+    address = "198.51.100.30"
+  }
+}
+
+resource "unifi_nat_rule" "server_outbound" {
+  description   = "Server 1:1 outbound"
+  type          = "SNAT"
+  out_interface = data.unifi_network.wan.id
+  translated_ip = "198.51.100.30"
+
+  source = {
+    address = "10.0.10.30"
+  }
+}
@@ -64,3 +64,26 @@ resource "unifi_network" "tailscale_lan" {
   dhcpd_gateway_enabled = true
   dhcpd_gateway         = "10.0.30.10"
 }
+
+# Advanced DHCP server options for VoIP phones and PXE clients. Options without a
+# dedicated attribute are set with `dhcp_custom_option` blocks.
+resource "unifi_network" "voip" {
+  name    = "voip"
+  purpose = "corporate"
+
+  subnet       = "10.0.40.1/24"
+  vlan_id      = 40
+  dhcp_start   = "10.0.40.100"
+  dhcp_stop    = "10.0.40.254"
+  dhcp_enabled = true
+
+  dhcp_ntp          = ["10.0.40.1"]
+  dhcpd_tftp_server = "10.0.40.5"
+  dhcpd_time_offset = -18000
+
+  dhcp_custom_option {
+    code  = 150
+    type  = "ipaddress"
+    value = "10.0.40.5"
+  }
+}
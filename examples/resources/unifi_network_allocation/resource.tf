@@ -0,0 +1,25 @@
+locals {
+  tenants = ["acme", "globex", "initech"]
+}
+
+resource "unifi_network_allocation" "tenant" {
+  for_each = toset(local.tenants)
+
+  supernet      = "10.20.0.0/16"
+  prefix_length = 24
+  vlan_id_min   = 100
+  vlan_id_max   = 199
+}
+
+resource "unifi_network" "tenant" {
+  for_each = toset(local.tenants)
+
+  name    = "tenant-${each.key}"
+  purpose = "corporate"
+
+  vlan_id      = unifi_network_allocation.tenant[each.key].vlan_id
+  subnet       = unifi_network_allocation.tenant[each.key].subnet
+  dhcp_start   = cidrhost(unifi_network_allocation.tenant[each.key].subnet, 6)
+  dhcp_stop    = cidrhost(unifi_network_allocation.tenant[each.key].subnet, 254)
+  dhcp_enabled = true
+}
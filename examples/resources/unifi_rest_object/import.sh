@@ -0,0 +1,2 @@
+# import using the site, the path of the collection and the ID of the object
+terraform import unifi_rest_object.block_social default:v2/trafficrules:5dc28e5e9106d105bdc87217
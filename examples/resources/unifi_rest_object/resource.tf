@@ -0,0 +1,25 @@
+resource "unifi_rest_object" "block_social" {
+  path = "v2/trafficrules"
+  body = jsonencode({
+    description      = "Block social media"
+    enabled          = true
+    action           = "BLOCK"
+    matching_target  = "APP_CATEGORY"
+    app_category_ids = [24]
+
+    target_devices = [{
+      type = "ALL_CLIENTS"
+    }]
+  })
+}
+
+resource "unifi_rest_object" "radius_account" {
+  path = "rest/account"
+  body = jsonencode({
+    name       = "printer"
+    x_password = var.printer_password
+  })
+
+  # The controller does not return the password as sent
+  ignore_fields = ["x_password"]
+}
@@ -0,0 +1,2 @@
+# import using the site and the key of the setting
+terraform import unifi_setting.example default:ntp
@@ -0,0 +1,7 @@
+resource "unifi_setting" "example" {
+  key = "ntp"
+  value = jsonencode({
+    setting_preference = "manual"
+    ntp_server_1       = "pool.ntp.org"
+  })
+}
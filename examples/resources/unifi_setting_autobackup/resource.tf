@@ -0,0 +1,11 @@
+resource "unifi_setting_autobackup" "example" {
+  enabled = true
+
+  # Every Monday at 1 AM
+  cron_expr = "0 1 * * 1"
+  timezone  = "Europe/Warsaw"
+
+  # Keep the last 8 backups, including 30 days of history
+  max_files = 8
+  days      = 30
+}
@@ -0,0 +1,11 @@
+resource "unifi_setting_global_ap" "example" {
+  channel_width_ng = 20
+  tx_power_mode_ng = "low"
+  channel_width_na = 80
+  tx_power_mode_na = "auto"
+
+  wifi6e_enabled            = true
+  mesh_enabled              = false
+  roaming_assistant_enabled = true
+  band_steering_mode        = "prefer_5g"
+}
@@ -0,0 +1,16 @@
+resource "unifi_setting_radio_ai" "example" {
+  enabled   = true
+  cron_expr = "0 3 * * *"
+  radios    = ["ng", "na"]
+  optimize  = ["channel", "power"]
+
+  # Keep channels used by neighbouring networks free
+  excluded_channels_ng = [13]
+  excluded_channels_na = [36, 40]
+
+  channel_widths_ng = [20]
+  channel_widths_na = [40, 80]
+
+  # Avoid channels shared with radars
+  dfs = false
+}
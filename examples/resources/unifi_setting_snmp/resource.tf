@@ -0,0 +1,14 @@
+resource "unifi_setting_snmp" "example" {
+  # SNMPv1/v2c
+  enabled   = true
+  community = var.snmp_community
+
+  # SNMPv3
+  v3_enabled         = true
+  username           = "monitoring"
+  auth_passphrase    = var.snmp_auth_passphrase
+  privacy_passphrase = var.snmp_privacy_passphrase
+
+  # Bump after changing the passphrases to send them to the controller
+  passphrases_version = 1
+}
@@ -0,0 +1,3 @@
+resource "unifi_setting_super_cloud_access" "example" {
+  enabled = false
+}
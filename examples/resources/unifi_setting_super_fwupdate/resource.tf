@@ -0,0 +1,8 @@
+resource "unifi_setting_super_fwupdate" "example" {
+  controller_channel = "release"
+  firmware_channel   = "release"
+
+  # Update the controller automatically between 3 and 4 AM
+  auto_update      = true
+  auto_update_hour = 3
+}
@@ -0,0 +1,2 @@
+# import using the ID of the setting
+terraform import unifi_setting_super_identity.example 5dc28e5e9106d105bdc87217
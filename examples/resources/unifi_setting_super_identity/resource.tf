@@ -0,0 +1,4 @@
+resource "unifi_setting_super_identity" "example" {
+  name     = "HQ controller"
+  hostname = "unifi.example.com"
+}
@@ -0,0 +1,13 @@
+resource "unifi_setting_super_smtp" "example" {
+  host     = "smtp.example.com"
+  port     = 465
+  use_ssl  = true
+  username = "alerts@example.com"
+  password = var.smtp_password
+
+  # Bump to send a changed password to the controller
+  password_version = 1
+
+  # Send e-mails from a custom address instead of the controller's default sender
+  sender = "unifi@example.com"
+}
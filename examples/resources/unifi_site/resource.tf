@@ -1,3 +1,10 @@
 resource "unifi_site" "mysite" {
   description = "mysite"
 }
+
+# Clone networks, WLANs and settings of a template site into a new branch site
+resource "unifi_site" "branch" {
+  description   = "Branch 42"
+  source_site   = "Branch Template"
+  clone_objects = ["networks", "wlans", "user_groups", "settings"]
+}
@@ -0,0 +1,51 @@
+package acctest
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestClientsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.unifi_clients.test"
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "unifi_clients" "test" {
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "site", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.#"),
+				),
+			},
+			{
+				Config: `
+data "unifi_clients" "test" {
+	is_wired       = true
+	hostname_regex = "^tfacc-no-such-client-"
+}
+`,
+				Check: resource.TestCheckResourceAttr(dataSourceName, "result.#", "0"),
+			},
+		},
+	})
+}
+
+func TestClientsDataSource_invalidRegex(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "unifi_clients" "test" {
+	hostname_regex = "("
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid hostname_regex`),
+			},
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package acctest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestControllerDataSource_basic(t *testing.T) {
+	dataSourceName := "data.unifi_controller.test"
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "unifi_controller" "test" {
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "site", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "build"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "hostname"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "unifi_os"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "available_features.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "capabilities.wpa3", "true"),
+				),
+			},
+		},
+	})
+}
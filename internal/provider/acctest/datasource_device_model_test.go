@@ -0,0 +1,65 @@
+package acctest
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDeviceModelDataSourceName = "data.unifi_device_model.test"
+
+func TestDeviceModelDataSource_basic(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceModelDataSourceConfig("US24P250"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testDeviceModelDataSourceName, "id", "US24P250"),
+					resource.TestCheckResourceAttr(testDeviceModelDataSourceName, "name", "US-24-250W"),
+					resource.TestCheckResourceAttr(testDeviceModelDataSourceName, "type", "usw"),
+					resource.TestCheckResourceAttr(testDeviceModelDataSourceName, "ports", "26"),
+					resource.TestCheckResourceAttr(testDeviceModelDataSourceName, "poe_ports.#", "24"),
+					resource.TestCheckResourceAttr(testDeviceModelDataSourceName, "passthrough_ports.#", "0"),
+					resource.TestCheckResourceAttr(testDeviceModelDataSourceName, "sfp_ports.#", "2"),
+					resource.TestCheckResourceAttr(testDeviceModelDataSourceName, "radios.#", "0"),
+				),
+			},
+			{
+				Config: testAccDeviceModelDataSourceConfig("U7PG2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testDeviceModelDataSourceName, "type", "uap"),
+					resource.TestCheckResourceAttr(testDeviceModelDataSourceName, "radios.#", "2"),
+				),
+			},
+			{
+				Config: testAccDeviceModelDataSourceConfig("US8"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testDeviceModelDataSourceName, "poe_ports.#", "0"),
+					resource.TestCheckResourceAttr(testDeviceModelDataSourceName, "passthrough_ports.#", "1"),
+					resource.TestCheckResourceAttr(testDeviceModelDataSourceName, "passthrough_ports.0", "8"),
+				),
+			},
+		},
+	})
+}
+
+func TestDeviceModelDataSource_unknownModel(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeviceModelDataSourceConfig("NOTAMODEL"),
+				ExpectError: regexp.MustCompile(`is not in the device model catalog`),
+			},
+		},
+	})
+}
+
+func testAccDeviceModelDataSourceConfig(model string) string {
+	return fmt.Sprintf(`
+data "unifi_device_model" "test" {
+	model = %q
+}
+`, model)
+}
@@ -0,0 +1,98 @@
+package acctest
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDeviceDataSource_byMAC(t *testing.T) {
+	dataSourceName := "data.unifi_device.test"
+
+	device, unallocateDevice := allocateDevice(t)
+	defer unallocateDevice()
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceDataSourceConfigByMAC(device.MAC),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", device.ID),
+					resource.TestCheckResourceAttr(dataSourceName, "mac", device.MAC),
+					resource.TestCheckResourceAttr(dataSourceName, "model", device.Model),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "usw"),
+					resource.TestCheckResourceAttr(dataSourceName, "site", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "port_table.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestDeviceDataSource_notFound(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeviceDataSourceConfigByName("tfacc-no-such-device"),
+				ExpectError: regexp.MustCompile(`No device with name "tfacc-no-such-device" found`),
+			},
+		},
+	})
+}
+
+func TestDevicesDataSource_filters(t *testing.T) {
+	dataSourceName := "data.unifi_devices.test"
+
+	device, unallocateDevice := allocateDevice(t)
+	defer unallocateDevice()
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDevicesDataSourceConfig(device.Model),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.model", device.Model),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.type", "usw"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "result.*", map[string]string{
+						"mac": device.MAC,
+					}),
+				),
+			},
+			{
+				Config: `
+data "unifi_devices" "test" {
+	name_regex = "^tfacc-no-such-device-"
+}
+`,
+				Check: resource.TestCheckResourceAttr(dataSourceName, "result.#", "0"),
+			},
+		},
+	})
+}
+
+func testAccDeviceDataSourceConfigByMAC(mac string) string {
+	return fmt.Sprintf(`
+data "unifi_device" "test" {
+	mac = %q
+}
+`, mac)
+}
+
+func testAccDeviceDataSourceConfigByName(name string) string {
+	return fmt.Sprintf(`
+data "unifi_device" "test" {
+	name = %q
+}
+`, name)
+}
+
+func testAccDevicesDataSourceConfig(model string) string {
+	return fmt.Sprintf(`
+data "unifi_devices" "test" {
+	type  = "usw"
+	model = %q
+}
+`, model)
+}
@@ -0,0 +1,45 @@
+package acctest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFirewallGroupsDataSource_filters(t *testing.T) {
+	dataSourceName := "data.unifi_firewall_groups.test"
+	name := acctest.RandomWithPrefix("tfacc")
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallGroupConfig(name, "port-group", []string{"80", "443"}) + testAccFirewallGroupsDataSourceConfig(name, "port-group"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "result.0.id", "unifi_firewall_group.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.type", "port-group"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "result.0.members.*", "443"),
+				),
+			},
+			{
+				Config: testAccFirewallGroupConfig(name, "port-group", []string{"80", "443"}) + testAccFirewallGroupsDataSourceConfig(name, "address-group"),
+				Check:  resource.TestCheckResourceAttr(dataSourceName, "result.#", "0"),
+			},
+		},
+	})
+}
+
+func testAccFirewallGroupsDataSourceConfig(name, groupType string) string {
+	return fmt.Sprintf(`
+data "unifi_firewall_groups" "test" {
+	filter = {
+		name_regex = "^%s$"
+		purpose    = %q
+	}
+
+	depends_on = [unifi_firewall_group.test]
+}
+`, name, groupType)
+}
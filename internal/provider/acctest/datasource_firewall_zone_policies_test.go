@@ -0,0 +1,31 @@
+package acctest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
+)
+
+func TestFirewallZonePoliciesDataSource_basic(t *testing.T) {
+	pt.SkipIfEnvLocalMissing(t, "Skipping, because test environment does not support firewall zones yet, and no idea how to enable it")
+	dataSourceName := "data.unifi_firewall_zone_policies.test"
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		VersionConstraint: ">= 9.0.0",
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "unifi_firewall_zone_policies" "test" {
+	filter = {
+		name_regex = "^tfacc-no-such-policy-"
+		purpose    = "BLOCK"
+	}
+}
+`,
+				Check: resource.TestCheckResourceAttr(dataSourceName, "result.#", "0"),
+			},
+		},
+	})
+}
@@ -0,0 +1,72 @@
+package acctest
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
+)
+
+func TestNetworksDataSource_filters(t *testing.T) {
+	dataSourceName := "data.unifi_networks.test"
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet, vlan := pt.GetTestVLAN(t)
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkConfig(name, subnet, vlan, false, nil) + testAccNetworksDataSourceConfig(name, vlan, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "result.0.id", "unifi_network.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.purpose", "corporate"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.vlan_id", strconv.Itoa(vlan)),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.subnet", subnet.String()),
+				),
+			},
+			{
+				Config: testAccNetworkConfig(name, subnet, vlan, false, nil) + testAccNetworksDataSourceConfig(name, vlan+1, vlan+1),
+				Check:  resource.TestCheckResourceAttr(dataSourceName, "result.#", "0"),
+			},
+		},
+	})
+}
+
+func TestNetworksDataSource_invalidVLANRange(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "unifi_networks" "test" {
+	filter = {
+		vlan_min = 20
+		vlan_max = 10
+	}
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid VLAN range`),
+			},
+		},
+	})
+}
+
+func testAccNetworksDataSourceConfig(name string, vlanMin, vlanMax int) string {
+	return fmt.Sprintf(`
+data "unifi_networks" "test" {
+	filter = {
+		name_regex = "^%[1]s$"
+		purpose    = "corporate"
+		vlan_min   = %[2]d
+		vlan_max   = %[3]d
+	}
+
+	depends_on = [unifi_network.test]
+}
+`, name, vlanMin, vlanMax)
+}
@@ -0,0 +1,46 @@
+package acctest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPortForwardsDataSource_filters(t *testing.T) {
+	dataSourceName := "data.unifi_port_forwards.test"
+	name := acctest.RandomWithPrefix("tfacc")
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortForwardConfig("22", false, "10.1.1.1", "22", name) + testAccPortForwardsDataSourceConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "result.0.id", "unifi_port_forward.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.fwd_ip", "10.1.1.1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.dst_port", "22"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.enabled", "false"),
+				),
+			},
+			{
+				Config: testAccPortForwardConfig("22", false, "10.1.1.1", "22", name) + testAccPortForwardsDataSourceConfig(name, true),
+				Check:  resource.TestCheckResourceAttr(dataSourceName, "result.#", "0"),
+			},
+		},
+	})
+}
+
+func testAccPortForwardsDataSourceConfig(name string, enabled bool) string {
+	return fmt.Sprintf(`
+data "unifi_port_forwards" "test" {
+	filter = {
+		name_regex = "^%s$"
+		enabled    = %t
+	}
+
+	depends_on = [unifi_port_forward.test]
+}
+`, name, enabled)
+}
@@ -0,0 +1,48 @@
+package acctest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSitesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.unifi_sites.test"
+	desc := acctest.RandomWithPrefix("tfacc")
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "unifi_sites" "test" {
+}
+`,
+				Check: resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "result.*", map[string]string{
+					"name": "default",
+				}),
+			},
+			{
+				Config: testAccSiteConfig(desc) + testAccSitesDataSourceConfig(desc),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "result.0.id", "unifi_site.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "result.0.name", "unifi_site.test", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.description", desc),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.device_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSitesDataSourceConfig(desc string) string {
+	return fmt.Sprintf(`
+data "unifi_sites" "test" {
+	description_regex = "^%s$"
+
+	depends_on = [unifi_site.test]
+}
+`, desc)
+}
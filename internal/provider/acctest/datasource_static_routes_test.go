@@ -0,0 +1,50 @@
+package acctest
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestStaticRoutesDataSource_filters(t *testing.T) {
+	dataSourceName := "data.unifi_static_routes.test"
+	name := acctest.RandomWithPrefix("tfacc")
+	network := &net.IPNet{
+		IP:   net.IPv4(172, 18, 0, 0).To4(),
+		Mask: net.IPv4Mask(255, 255, 0, 0),
+	}
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStaticRouteConfigBlackhole(name, network, 1) + testAccStaticRoutesDataSourceConfig(name, "blackhole"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "result.0.id", "unifi_static_route.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.type", "blackhole"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.network", network.String()),
+				),
+			},
+			{
+				Config: testAccStaticRouteConfigBlackhole(name, network, 1) + testAccStaticRoutesDataSourceConfig(name, "nexthop-route"),
+				Check:  resource.TestCheckResourceAttr(dataSourceName, "result.#", "0"),
+			},
+		},
+	})
+}
+
+func testAccStaticRoutesDataSourceConfig(name, routeType string) string {
+	return fmt.Sprintf(`
+data "unifi_static_routes" "test" {
+	filter = {
+		name_regex = "^%s$"
+		purpose    = %q
+	}
+
+	depends_on = [unifi_static_route.test]
+}
+`, name, routeType)
+}
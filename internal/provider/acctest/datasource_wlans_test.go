@@ -0,0 +1,37 @@
+package acctest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestWLANsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.unifi_wlans.test"
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "unifi_wlans" "test" {
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "site", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.#"),
+				),
+			},
+			{
+				Config: `
+data "unifi_wlans" "test" {
+	filter = {
+		name_regex = "^tfacc-no-such-wlan-"
+		enabled    = true
+	}
+}
+`,
+				Check: resource.TestCheckResourceAttr(dataSourceName, "result.#", "0"),
+			},
+		},
+	})
+}
@@ -0,0 +1,101 @@
+package acctest
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
+)
+
+func TestAccAdmin_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc-admin")
+	resourceName := "unifi_admin.test"
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdminConfig(name, "readonly", "Passw0rd!1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "site", "default"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "email", name+"@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "role", "readonly"),
+					resource.TestCheckResourceAttr(resourceName, "requires_new_password", "false"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions(resourceName, plancheck.ResourceActionCreate),
+			},
+			pt.ImportStepWithSite(resourceName, "password"),
+			{
+				Config: testAccAdminConfig(name, "admin", "Passw0rd!2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role", "admin"),
+					resource.TestCheckResourceAttr(resourceName, "password", "Passw0rd!2"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions(resourceName, plancheck.ResourceActionUpdate),
+			},
+		},
+	})
+}
+
+func TestAccAdminSiteAccess_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc-admin")
+	resourceName := "unifi_admin_site_access.test"
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdminSiteAccessConfig(name, "readonly"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "admin_id", "unifi_admin.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "site", "unifi_site.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "role", "readonly"),
+				),
+			},
+			pt.ImportStepWithSite(resourceName),
+			{
+				Config: testAccAdminSiteAccessConfig(name, "admin"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "role", "admin"),
+			},
+			{
+				// The site of the unifi_admin resource is already accessible.
+				Config: testAccAdminSiteAccessConfig(name, "admin") + `
+resource "unifi_admin_site_access" "own_site" {
+	admin_id = unifi_admin.test.id
+}
+`,
+				ExpectError: regexp.MustCompile(`already has access to site default`),
+			},
+		},
+	})
+}
+
+func testAccAdminConfig(name, role, password string) string {
+	return fmt.Sprintf(`
+resource "unifi_admin" "test" {
+	name     = %[1]q
+	email    = "%[1]s@example.com"
+	role     = %[2]q
+	password = %[3]q
+}
+`, name, role, password)
+}
+
+func testAccAdminSiteAccessConfig(name, role string) string {
+	return testAccAdminConfig(name, "readonly", "Passw0rd!1") + fmt.Sprintf(`
+resource "unifi_site" "test" {
+	description = %[1]q
+}
+
+resource "unifi_admin_site_access" "test" {
+	site     = unifi_site.test.name
+	admin_id = unifi_admin.test.id
+	role     = %[2]q
+}
+`, name, role)
+}
@@ -0,0 +1,81 @@
+package acctest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
+)
+
+func TestAccBackup_basic(t *testing.T) {
+	resourceName := "unifi_backup.test"
+	file := filepath.Join(t.TempDir(), "backups", "controller.unf")
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:           testAccBackupConfig(file, "first"),
+				ConfigPlanChecks: pt.CheckResourceActions(resourceName, plancheck.ResourceActionCreate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "filename", regexp.MustCompile(`\.unf$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "size"),
+					testAccCheckBackupFile(resourceName, file),
+				),
+			},
+			{
+				// Unchanged triggers take no new backup.
+				Config:           testAccBackupConfig(file, "first"),
+				ConfigPlanChecks: pt.CheckResourceActions(resourceName, plancheck.ResourceActionNoop),
+			},
+			{
+				Config:           testAccBackupConfig(file, "second"),
+				ConfigPlanChecks: pt.CheckResourceActions(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+				Check:            testAccCheckBackupFile(resourceName, file),
+			},
+		},
+	})
+}
+
+func testAccBackupConfig(file, trigger string) string {
+	return fmt.Sprintf(`
+resource "unifi_backup" "test" {
+	path = %q
+
+	triggers = {
+		run = %q
+	}
+}
+`, file, trigger)
+}
+
+// testAccCheckBackupFile checks the backup was written to the file with the
+// reported checksum.
+func testAccCheckBackupFile(name, file string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(content)
+		if checksum := hex.EncodeToString(sum[:]); checksum != rs.Primary.Attributes["sha256"] {
+			return fmt.Errorf("expected checksum %s of %s, got %s", rs.Primary.Attributes["sha256"], file, checksum)
+		}
+		if size := fmt.Sprint(len(content)); size != rs.Primary.Attributes["size"] {
+			return fmt.Errorf("expected size %s of %s, got %s", rs.Primary.Attributes["size"], file, size)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,147 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDevicePort_basic(t *testing.T) {
+	resourceName := "unifi_device_port.test"
+	site := "default"
+
+	device, unallocateDevice := allocateDevice(t)
+	defer unallocateDevice()
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		PreCheck: func() {
+			preCheckDeviceExists(t, site, device.MAC)
+		},
+		CheckDestroy: testAccCheckDevicePortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDevicePortConfig(device.MAC, 3, "Port 3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", device.MAC+"/3"),
+					resource.TestCheckResourceAttr(resourceName, "site", site),
+					resource.TestCheckResourceAttr(resourceName, "number", "3"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Port 3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDevicePortConfig(device.MAC, 3, "Renamed"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "name", "Renamed"),
+			},
+		},
+	})
+}
+
+// TestAccDevicePort_withDevice checks that a unifi_device resource leaves the
+// ports owned by unifi_device_port resources of the same switch untouched.
+func TestAccDevicePort_withDevice(t *testing.T) {
+	site := "default"
+
+	device, unallocateDevice := allocateDevice(t)
+	defer unallocateDevice()
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		PreCheck: func() {
+			preCheckDeviceExists(t, site, device.MAC)
+		},
+		CheckDestroy: testAccCheckDevicePortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDevicePortConfigWithDevice(device.MAC),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_device.test", "port_override.#", "1"),
+					resource.TestCheckResourceAttr("unifi_device_port.port2", "name", "Port 2"),
+					resource.TestCheckResourceAttr("unifi_device_port.port4", "name", "Port 4"),
+				),
+			},
+			// Neither resource may overwrite the other's ports.
+			{
+				Config:   testAccDevicePortConfigWithDevice(device.MAC),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDevicePortDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "unifi_device_port" {
+			continue
+		}
+
+		device, err := testClient.GetDeviceByMAC(ctx, rs.Primary.Attributes["site"], rs.Primary.Attributes["device_mac"])
+		if err != nil {
+			return err
+		}
+		number, err := strconv.Atoi(rs.Primary.Attributes["number"])
+		if err != nil {
+			return err
+		}
+		if hasPortOverride(device, number) {
+			return fmt.Errorf("Port override %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func hasPortOverride(device *unifi.Device, number int) bool {
+	for _, po := range device.PortOverrides {
+		if po.PortIDX == number {
+			return true
+		}
+	}
+	return false
+}
+
+func testAccDevicePortConfig(mac string, number int, name string) string {
+	return fmt.Sprintf(`
+resource "unifi_device_port" "test" {
+	device_mac = %q
+	number     = %d
+	name       = %q
+}
+`, mac, number, name)
+}
+
+func testAccDevicePortConfigWithDevice(mac string) string {
+	return fmt.Sprintf(`
+resource "unifi_device" "test" {
+	mac               = %[1]q
+	forget_on_destroy = false
+
+	port_override {
+		number = 1
+		name   = "Port 1"
+	}
+}
+
+resource "unifi_device_port" "port2" {
+	device_mac = %[1]q
+	number     = 2
+	name       = "Port 2"
+}
+
+resource "unifi_device_port" "port4" {
+	device_mac = %[1]q
+	number     = 4
+	name       = "Port 4"
+}
+`, mac)
+}
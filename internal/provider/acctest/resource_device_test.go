@@ -15,6 +15,7 @@
 	"github.com/filipowm/go-unifi/unifi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
@@ -245,6 +246,49 @@ func TestAccDevice_switch_basic(t *testing.T) {
 	})
 }
 
+func TestAccDevice_switch_moveSite(t *testing.T) {
+	resourceName := "unifi_device.test"
+	site := "default"
+
+	device, unallocateDevice := allocateDevice(t)
+	defer unallocateDevice()
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		PreCheck: func() {
+			preCheckDeviceExists(t, site, device.MAC)
+		},
+		CheckDestroy: testAccCheckDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceConfigWithSite(device.MAC, `"default"`),
+				Check:  resource.TestCheckResourceAttr(resourceName, "site", site),
+			},
+			{
+				// Moving the device updates it in place instead of forgetting it,
+				// and its port override follows it to the new site.
+				Config: testAccDeviceConfigWithSite(device.MAC, "unifi_site.target.name"),
+				ConfigPlanChecks: pt.CheckPlanPreApply(
+					plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					plancheck.ExpectResourceAction("unifi_device_port.test", plancheck.ResourceActionUpdate),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "site", "unifi_site.target", "name"),
+					resource.TestCheckResourceAttr(resourceName, "mac", device.MAC),
+					resource.TestCheckResourceAttrPair("unifi_device_port.test", "site", "unifi_site.target", "name"),
+					resource.TestCheckResourceAttr("unifi_device_port.test", "name", "tfacc-moved"),
+				),
+			},
+			{
+				// Move it back, so the device is left in the site the device pool expects.
+				Config:           testAccDeviceConfigWithSite(device.MAC, `"default"`),
+				ConfigPlanChecks: pt.CheckResourceActions(resourceName, plancheck.ResourceActionUpdate),
+				Check:            resource.TestCheckResourceAttr(resourceName, "site", site),
+			},
+		},
+	})
+}
+
 // TestAccDevice_switch_portOverrides covers the port_override attributes the
 // Dockerized demo switches reliably accept and persist: per-port name, op_mode,
 // and poe_mode. Advanced overrides that the demo controller cannot faithfully
@@ -258,7 +302,8 @@ func TestAccDevice_switch_portOverrides(t *testing.T) {
 	device, unallocateDevice := allocateDevice(t)
 	defer unallocateDevice()
 
-	importStateVerifyIgnore := []string{"allow_adoption", "forget_on_destroy", "name"}
+	// Import owns no ports, as they may belong to unifi_device_port resources.
+	importStateVerifyIgnore := []string{"allow_adoption", "forget_on_destroy", "name", "port_override"}
 
 	AcceptanceTest(t, AcceptanceTestCase{
 		PreCheck: func() {
@@ -341,7 +386,8 @@ func TestAccDevice_switch_portOverrides_inlineVLAN(t *testing.T) {
 	device, unallocateDevice := allocateDevice(t)
 	defer unallocateDevice()
 
-	importStateVerifyIgnore := []string{"allow_adoption", "forget_on_destroy", "name"}
+	// Import owns no ports, as they may belong to unifi_device_port resources.
+	importStateVerifyIgnore := []string{"allow_adoption", "forget_on_destroy", "name", "port_override"}
 
 	AcceptanceTest(t, AcceptanceTestCase{
 		PreCheck: func() {
@@ -406,6 +452,24 @@ func TestAccDevice_switch_portOverrides_inlineVLAN(t *testing.T) {
 	})
 }
 
+func TestAccDevice_switch_portOverrides_invalidLayout(t *testing.T) {
+	device, unallocateDevice := allocateDevice(t)
+	defer unallocateDevice()
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeviceConfigWithLagMemberOverride(device.MAC),
+				ExpectError: regexp.MustCompile(`port 4 is a member of the LAG on port 3`),
+			},
+			{
+				Config:      testAccDeviceConfigWithMirrorPort(device.MAC, "switch"),
+				ExpectError: regexp.MustCompile(`"mirror_port_idx" is only valid when "op_mode" is "mirror"`),
+			},
+		},
+	})
+}
+
 func testAccDeviceConfigEmpty() string {
 	return `
 resource "unifi_device" "test" {}
@@ -429,6 +493,26 @@ func testAccDeviceConfigWithName(mac, name string) string {
 `, mac, name)
 }
 
+func testAccDeviceConfigWithSite(mac, site string) string {
+	return fmt.Sprintf(`
+resource "unifi_site" "target" {
+	description = "tfacc-device-move"
+}
+
+resource "unifi_device" "test" {
+	mac  = %q
+	site = %s
+}
+
+resource "unifi_device_port" "test" {
+	site       = unifi_device.test.site
+	device_mac = unifi_device.test.mac
+	number     = 1
+	name       = "tfacc-moved"
+}
+`, mac, site)
+}
+
 // testAccDeviceConfigWithPortOverridesBasic renders the port_override fields the
 // Dockerized demo switches reliably persist (name, op_mode, poe_mode). Used by the
 // always-on TestAccDevice_switch_portOverrides.
@@ -595,3 +679,36 @@ func testAccCheckDeviceExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func testAccDeviceConfigWithLagMemberOverride(mac string) string {
+	return fmt.Sprintf(`
+resource "unifi_device" "test" {
+	mac = %q
+
+	port_override {
+		number              = 3
+		op_mode             = "aggregate"
+		aggregate_num_ports = 2
+	}
+
+	port_override {
+		number = 4
+		name   = "LAG member"
+	}
+}
+`, mac)
+}
+
+func testAccDeviceConfigWithMirrorPort(mac, opMode string) string {
+	return fmt.Sprintf(`
+resource "unifi_device" "test" {
+	mac = %q
+
+	port_override {
+		number          = 8
+		op_mode         = %q
+		mirror_port_idx = 1
+	}
+}
+`, mac, opMode)
+}
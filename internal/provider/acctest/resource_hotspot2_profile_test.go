@@ -0,0 +1,152 @@
+package acctest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
+)
+
+const testHotspot2ProfileResourceName = "unifi_hotspot2_profile.test"
+
+func TestAccHotspot2Profile_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHotspot2ProfileConfig(name, "free-public"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testHotspot2ProfileResourceName, "id"),
+					resource.TestCheckResourceAttr(testHotspot2ProfileResourceName, "name", name),
+					resource.TestCheckResourceAttr(testHotspot2ProfileResourceName, "network_type", "free-public"),
+					resource.TestCheckResourceAttr(testHotspot2ProfileResourceName, "venue_names.#", "1"),
+					resource.TestCheckResourceAttr(testHotspot2ProfileResourceName, "venue_names.0.language", "eng"),
+					resource.TestCheckResourceAttr(testHotspot2ProfileResourceName, "nai_realms.0.eap_method", "eap-ttls"),
+					resource.TestCheckResourceAttr(testHotspot2ProfileResourceName, "nai_realms.0.encoding", "rfc4282"),
+					resource.TestCheckResourceAttr(testHotspot2ProfileResourceName, "roaming_consortium_ois.0.oi", "5A03BA"),
+					resource.TestCheckResourceAttr(testHotspot2ProfileResourceName, "plmn_ids.0.mcc", "310"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions(testHotspot2ProfileResourceName, plancheck.ResourceActionCreate),
+			},
+			pt.ImportStepWithSite(testHotspot2ProfileResourceName),
+			{
+				Config: testAccHotspot2ProfileConfig(name, "private"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testHotspot2ProfileResourceName, "network_type", "private"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions(testHotspot2ProfileResourceName, plancheck.ResourceActionUpdate),
+			},
+			{
+				Config: testAccHotspot2ProfileConfigNetworkAuth(name, `
+	network_auth_type = "http-redirection"
+	network_auth_url  = "https://portal.example.com/terms"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testHotspot2ProfileResourceName, "network_auth_type", "http-redirection"),
+					resource.TestCheckResourceAttr(testHotspot2ProfileResourceName, "network_auth_url", "https://portal.example.com/terms"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions(testHotspot2ProfileResourceName, plancheck.ResourceActionUpdate),
+			},
+			{
+				// Removing the authentication step clears it on the controller,
+				// leaving no diff behind.
+				Config: testAccHotspot2ProfileConfigNetworkAuth(name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(testHotspot2ProfileResourceName, "network_auth_type"),
+					resource.TestCheckNoResourceAttr(testHotspot2ProfileResourceName, "network_auth_url"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions(testHotspot2ProfileResourceName, plancheck.ResourceActionUpdate),
+			},
+		},
+		CheckDestroy: testAccCheckHotspot2ProfileDestroy,
+	})
+}
+
+func testAccCheckHotspot2ProfileDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "unifi_hotspot2_profile" {
+			continue
+		}
+
+		site := rs.Primary.Attributes["site"]
+		if site == "" {
+			site = "default"
+		}
+
+		_, err := base.GetRest[map[string]interface{}](context.Background(), testClient, site, "hotspot2conf", rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Hotspot 2.0 profile %s still exists", rs.Primary.ID)
+		}
+		if errors.Is(err, unifi.ErrNotFound) {
+			continue
+		}
+		return err
+	}
+	return nil
+}
+
+func testAccHotspot2ProfileConfig(name, networkType string) string {
+	return fmt.Sprintf(`
+resource "unifi_hotspot2_profile" "test" {
+	name         = %[1]q
+	venue_group  = 2
+	venue_type   = 8
+	network_type = %[2]q
+
+	venue_names = [{
+		name = "Test Venue"
+	}]
+
+	operator_names = [{
+		language = "eng"
+		name     = "Test Operator"
+	}]
+
+	domain_names = ["example.com"]
+
+	nai_realms = [{
+		name       = "example.com"
+		eap_method = "eap-ttls"
+	}]
+
+	roaming_consortium_ois = [{
+		name = "OpenRoaming"
+		oi   = "5A03BA"
+	}]
+
+	plmn_ids = [{
+		name = "Test Mobile"
+		mcc  = 310
+		mnc  = 410
+	}]
+}
+`, name, networkType)
+}
+
+func testAccHotspot2ProfileConfigNetworkAuth(name, networkAuth string) string {
+	return fmt.Sprintf(`
+resource "unifi_hotspot2_profile" "test" {
+	name         = %[1]q
+	network_type = "private"
+%[2]s
+	venue_names = [{
+		name = "Test Venue"
+	}]
+
+	nai_realms = [{
+		name       = "example.com"
+		eap_method = "eap-ttls"
+	}]
+}
+`, name, networkAuth)
+}
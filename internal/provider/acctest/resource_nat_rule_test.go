@@ -0,0 +1,187 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
+)
+
+const testNATRuleResourceName = "unifi_nat_rule.test"
+
+func TestAccNATRule_masquerade(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc-nat")
+	subnet, vlanID := pt.GetTestVLAN(t)
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		VersionConstraint: ">= 9.0.0",
+		CheckDestroy:      testAccCheckNATRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: pt.ComposeConfig(
+					testAccNATRulePreConfig(name, subnet.String(), vlanID),
+					testAccNATRuleMasqueradeConfig(name, subnet.String()),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testNATRuleResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testNATRuleResourceName, "rule_index"),
+					resource.TestCheckResourceAttr(testNATRuleResourceName, "site", "default"),
+					resource.TestCheckResourceAttr(testNATRuleResourceName, "type", "MASQUERADE"),
+					resource.TestCheckResourceAttr(testNATRuleResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testNATRuleResourceName, "ip_version", "IPV4"),
+					resource.TestCheckResourceAttr(testNATRuleResourceName, "protocol", "all"),
+					resource.TestCheckResourceAttr(testNATRuleResourceName, "source.address", subnet.String()),
+					resource.TestCheckResourceAttrPair(testNATRuleResourceName, "out_interface", "unifi_network.test", "id"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions(testNATRuleResourceName, plancheck.ResourceActionCreate),
+			},
+			pt.ImportStepWithSite(testNATRuleResourceName),
+		},
+	})
+}
+
+func TestAccNATRule_dnat(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc-nat")
+	subnet, vlanID := pt.GetTestVLAN(t)
+	translatedIP := make(net.IP, net.IPv4len)
+	copy(translatedIP, subnet.IP.To4())
+	translatedIP[3] = 10
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		VersionConstraint: ">= 9.0.0",
+		CheckDestroy:      testAccCheckNATRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: pt.ComposeConfig(
+					testAccNATRulePreConfig(name, subnet.String(), vlanID),
+					testAccNATRuleDNATConfig(name, translatedIP.String(), "8080"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testNATRuleResourceName, "type", "DNAT"),
+					resource.TestCheckResourceAttr(testNATRuleResourceName, "protocol", "tcp"),
+					resource.TestCheckResourceAttr(testNATRuleResourceName, "translated_ip", translatedIP.String()),
+					resource.TestCheckResourceAttr(testNATRuleResourceName, "translated_port", "8080"),
+					resource.TestCheckResourceAttr(testNATRuleResourceName, "source.address", "203.0.113.0/24"),
+					resource.TestCheckResourceAttr(testNATRuleResourceName, "destination.port", "80"),
+					resource.TestCheckResourceAttrPair(testNATRuleResourceName, "in_interface", "unifi_network.test", "id"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions(testNATRuleResourceName, plancheck.ResourceActionCreate),
+			},
+			{
+				Config: pt.ComposeConfig(
+					testAccNATRulePreConfig(name, subnet.String(), vlanID),
+					testAccNATRuleDNATConfig(name, translatedIP.String(), "8443"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testNATRuleResourceName, "translated_port", "8443"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions(testNATRuleResourceName, plancheck.ResourceActionUpdate),
+			},
+			pt.ImportStepWithSite(testNATRuleResourceName),
+		},
+	})
+}
+
+func TestAccNATRule_validation(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		VersionConstraint: ">= 9.0.0",
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "unifi_nat_rule" "test" {
+	type          = "SNAT"
+	out_interface = "abc"
+}
+`,
+				ExpectError: regexp.MustCompile(`translated_ip`),
+				PlanOnly:    true,
+			},
+			{
+				Config: `
+resource "unifi_nat_rule" "test" {
+	type            = "DNAT"
+	in_interface    = "abc"
+	translated_ip   = "10.0.0.10"
+	translated_port = "8080"
+}
+`,
+				ExpectError: regexp.MustCompile(`Ports can only be set when`),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+func testAccCheckNATRuleDestroy(s *terraform.State) error {
+	return pt.CheckDestroy("unifi_nat_rule", func(ctx context.Context, site, id string) error {
+		var rules []struct {
+			ID string `json:"_id"`
+		}
+		if err := testClient.Do(ctx, http.MethodGet, base.V2Path(site, "nat"), nil, &rules); err != nil {
+			return err
+		}
+		for _, r := range rules {
+			if r.ID == id {
+				return nil
+			}
+		}
+		return unifi.ErrNotFound
+	})(s)
+}
+
+func testAccNATRulePreConfig(name, subnet string, vlanID int) string {
+	return fmt.Sprintf(`
+resource "unifi_network" "test" {
+	name    = %[1]q
+	purpose = "corporate"
+	subnet  = %[2]q
+	vlan_id = "%[3]d"
+}
+`, name, subnet, vlanID)
+}
+
+func testAccNATRuleMasqueradeConfig(name, subnet string) string {
+	return fmt.Sprintf(`
+resource "unifi_nat_rule" "test" {
+	description   = %[1]q
+	type          = "MASQUERADE"
+	out_interface = unifi_network.test.id
+
+	source = {
+		address = %[2]q
+	}
+}
+`, name, subnet)
+}
+
+func testAccNATRuleDNATConfig(name, translatedIP, translatedPort string) string {
+	return fmt.Sprintf(`
+resource "unifi_nat_rule" "test" {
+	description     = %[1]q
+	type            = "DNAT"
+	protocol        = "tcp"
+	in_interface    = unifi_network.test.id
+	translated_ip   = %[2]q
+	translated_port = %[3]q
+
+	source = {
+		address = "203.0.113.0/24"
+	}
+
+	destination = {
+		port = "80"
+	}
+}
+`, name, translatedIP, translatedPort)
+}
@@ -0,0 +1,105 @@
+package acctest
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
+)
+
+func TestAccNetworkAllocation(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		CheckDestroy: testAccCheckNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkAllocationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("unifi_network_allocation.a", "vlan_id", regexp.MustCompile(`^39\d\d$`)),
+					resource.TestMatchResourceAttr("unifi_network_allocation.a", "subnet", regexp.MustCompile(`^172\.30\.\d+\.0/24$`)),
+					resource.TestCheckResourceAttrPair("unifi_network_allocation.a", "subnet", "unifi_network_allocation.a", "id"),
+					resource.TestCheckResourceAttrPair("unifi_network.a", "vlan_id", "unifi_network_allocation.a", "vlan_id"),
+					resource.TestCheckResourceAttrPair("unifi_network.a", "subnet", "unifi_network_allocation.a", "subnet"),
+					testAccCheckNetworkAllocationsDistinct("unifi_network_allocation.a", "unifi_network_allocation.b"),
+				),
+			},
+			{
+				// The allocation is kept although its network now uses the allocated values.
+				Config:           testAccNetworkAllocationConfig(name),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_network_allocation.a", plancheck.ResourceActionNoop),
+			},
+			{
+				Config:      testAccNetworkAllocationInvalidConfig(),
+				ExpectError: regexp.MustCompile("must not be shorter than the prefix length of `supernet`"),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkAllocationsDistinct(a, b string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ra, ok := s.RootModule().Resources[a]
+		if !ok {
+			return fmt.Errorf("resource %s not found", a)
+		}
+		rb, ok := s.RootModule().Resources[b]
+		if !ok {
+			return fmt.Errorf("resource %s not found", b)
+		}
+		for _, attr := range []string{"vlan_id", "subnet"} {
+			if ra.Primary.Attributes[attr] == rb.Primary.Attributes[attr] {
+				return fmt.Errorf("%s and %s allocated the same %s %s", a, b, attr, ra.Primary.Attributes[attr])
+			}
+		}
+		return nil
+	}
+}
+
+func testAccNetworkAllocationConfig(name string) string {
+	return fmt.Sprintf(`
+resource "unifi_network_allocation" "a" {
+	supernet      = "172.30.0.0/16"
+	prefix_length = 24
+	vlan_id_min   = 3900
+	vlan_id_max   = 3999
+}
+
+resource "unifi_network_allocation" "b" {
+	supernet      = "172.30.0.0/16"
+	prefix_length = 24
+	vlan_id_min   = 3900
+	vlan_id_max   = 3999
+}
+
+resource "unifi_network" "a" {
+	name    = "%[1]s-a"
+	purpose = "corporate"
+	vlan_id = unifi_network_allocation.a.vlan_id
+	subnet  = unifi_network_allocation.a.subnet
+}
+
+resource "unifi_network" "b" {
+	name    = "%[1]s-b"
+	purpose = "corporate"
+	vlan_id = unifi_network_allocation.b.vlan_id
+	subnet  = unifi_network_allocation.b.subnet
+}
+`, name)
+}
+
+func testAccNetworkAllocationInvalidConfig() string {
+	return `
+resource "unifi_network_allocation" "invalid" {
+	supernet      = "172.30.0.0/16"
+	prefix_length = 12
+}
+`
+}
@@ -128,6 +128,61 @@ func TestAccNetwork_dhcp_boot(t *testing.T) {
 	})
 }
 
+func TestAccNetwork_dhcpOptions(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet, vlan := pt.GetTestVLAN(t)
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		CheckDestroy: testAccCheckNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkConfigDHCPOptions(name, subnet, vlan, "10.0.0.5"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_ntp.#", "2"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_wins.0", "192.168.1.104"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcpd_tftp_server", "192.168.1.180"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcpd_wpad_url", "http://proxy.example.com/wpad.dat"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcpd_unifi_controller", "192.168.1.10"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcpd_time_offset", "-18000"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_custom_option.#", "3"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_custom_option.0.value", "10.0.0.5"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_custom_option.2.type", "int"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_custom_option.2.width", "32"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_custom_option.2.signed", "true"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_custom_option.2.value", "-300"),
+				),
+			},
+			pt.ImportStep("unifi_network.test"),
+			{
+				Config: testAccNetworkConfigDHCPOptions(name, subnet, vlan, "10.0.0.6"),
+				Check:  resource.TestCheckResourceAttr("unifi_network.test", "dhcp_custom_option.0.value", "10.0.0.6"),
+			},
+			{
+				Config: testAccNetworkConfigDHCPBoot(name, subnet, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_ntp.#", "0"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_custom_option.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetwork_dhcpCustomOptionValidation(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet, vlan := pt.GetTestVLAN(t)
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNetworkConfigDHCPOptions(name, subnet, vlan, "not-an-ip"),
+				ExpectError: regexp.MustCompile(`invalid value "not-an-ip" of DHCP option 150`),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
 func TestAccNetwork_v6(t *testing.T) {
 	t.Skip("FIXME")
 
@@ -675,6 +730,53 @@ func testAccNetworkConfigDHCPBoot(name string, subnet *net.IPNet, vlan int) stri
 `, name, subnet, vlan)
 }
 
+func testAccNetworkConfigDHCPOptions(name string, subnet *net.IPNet, vlan int, voipServer string) string {
+	return fmt.Sprintf(`
+locals {
+	subnet  = "%[2]s"
+	vlan_id = %[3]d
+}
+
+resource "unifi_network" "test" {
+	name    = "%[1]s"
+	purpose = "corporate"
+
+	subnet       = local.subnet
+	vlan_id      = local.vlan_id
+	dhcp_start   = cidrhost(local.subnet, 6)
+	dhcp_stop    = cidrhost(local.subnet, 254)
+	dhcp_enabled = true
+
+	dhcp_ntp               = ["192.168.1.102", "192.168.1.103"]
+	dhcp_wins              = ["192.168.1.104"]
+	dhcpd_tftp_server      = "192.168.1.180"
+	dhcpd_wpad_url         = "http://proxy.example.com/wpad.dat"
+	dhcpd_unifi_controller = "192.168.1.10"
+	dhcpd_time_offset      = -18000
+
+	dhcp_custom_option {
+		code  = 150
+		type  = "ipaddress"
+		value = "%[4]s"
+	}
+
+	dhcp_custom_option {
+		code  = 160
+		type  = "text"
+		value = "https://provisioning.example.com"
+	}
+
+	dhcp_custom_option {
+		code   = 224
+		type   = "int"
+		width  = 32
+		signed = true
+		value  = "-300"
+	}
+}
+`, name, subnet, vlan, voipServer)
+}
+
 func testAccNetworkConfig(name string, subnet *net.IPNet, vlan int, igmpSnoop bool, dhcpDNS []string) string {
 	return fmt.Sprintf(`
 locals {
@@ -1115,6 +1217,57 @@ func TestAccNetwork_defaultGatewayValidation(t *testing.T) {
 	})
 }
 
+// TestAccNetwork_ipamValidation exercises the plan-time address plan checks: a DHCP
+// range outside of the subnet, and a subnet overlapping a network already on the
+// controller. The second network is only added once the first one exists, as the
+// overlap is checked against the controller.
+func TestAccNetwork_ipamValidation(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet, vlan := pt.GetTestVLAN(t)
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		CheckDestroy: testAccCheckNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNetworkConfigDHCPRange(name, subnet, vlan, "cidrhost(local.subnet, 6)", `"172.16.0.10"`),
+				ExpectError: regexp.MustCompile(`DHCP range .* is not within subnet`),
+			},
+			{
+				Config:      testAccNetworkConfigDHCPRange(name, subnet, vlan, "cidrhost(local.subnet, 1)", "cidrhost(local.subnet, 254)"),
+				ExpectError: regexp.MustCompile(`includes the gateway address`),
+			},
+			{
+				Config: testAccNetworkConfigDHCPRange(name, subnet, vlan, "cidrhost(local.subnet, 6)", "cidrhost(local.subnet, 254)"),
+			},
+			{
+				Config: testAccNetworkConfigDHCPRange(name, subnet, vlan, "cidrhost(local.subnet, 6)", "cidrhost(local.subnet, 254)") +
+					testAccNetworkConfigMDNS(name+"-overlap", subnet, vlan+1, false),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta(fmt.Sprintf("overlaps with subnet %s of network %q", subnet, name))),
+			},
+		},
+	})
+}
+
+func testAccNetworkConfigDHCPRange(name string, subnet *net.IPNet, vlan int, start, stop string) string {
+	return fmt.Sprintf(`
+locals {
+	subnet  = "%[2]s"
+	vlan_id = %[3]d
+}
+
+resource "unifi_network" "ipam" {
+	name    = "%[1]s"
+	purpose = "corporate"
+
+	subnet       = local.subnet
+	vlan_id      = local.vlan_id
+	dhcp_start   = %[4]s
+	dhcp_stop    = %[5]s
+	dhcp_enabled = true
+}
+`, name, subnet, vlan, start, stop)
+}
+
 // testAccNetworkConfigDefaultGatewayNoRange renders a corporate DHCP network with the
 // default-gateway override enabled but no dhcp_start/dhcp_stop, exercising the range
 // gate in customizeNetworkDefaultGateway.
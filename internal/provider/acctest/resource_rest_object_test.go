@@ -0,0 +1,82 @@
+package acctest
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
+)
+
+func TestAccRestObject(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "unifi_rest_object" "test" {
+	path = "rest/firewallgroup"
+	body = jsonencode({
+		name          = "tfacc-rest-object"
+		group_type    = "address-group"
+		group_members = ["10.0.0.1"]
+	})
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unifi_rest_object.test", "id"),
+					resource.TestCheckResourceAttr("unifi_rest_object.test", "site", "default"),
+					resource.TestCheckResourceAttr("unifi_rest_object.test", "id_attribute", "_id"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_rest_object.test", plancheck.ResourceActionCreate),
+			},
+			{
+				Config: `
+resource "unifi_rest_object" "test" {
+	path = "rest/firewallgroup"
+	body = jsonencode({
+		name          = "tfacc-rest-object"
+		group_type    = "address-group"
+		group_members = ["10.0.0.1", "10.0.0.2"]
+	})
+}
+
+data "unifi_rest_objects" "test" {
+	path = "rest/firewallgroup"
+
+	depends_on = [unifi_rest_object.test]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.unifi_rest_objects.test", "objects.#"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_rest_object.test", plancheck.ResourceActionUpdate),
+			},
+			{
+				ResourceName:            "unifi_rest_object.test",
+				ImportState:             true,
+				ImportStateIdFunc:       restObjectImportID("unifi_rest_object.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body"},
+			},
+			{
+				Config: `
+resource "unifi_rest_object" "test" {
+	path = "stat/device"
+	body = jsonencode({})
+}
+`,
+				ExpectError: regexp.MustCompile(`must be`),
+			},
+		},
+	})
+}
+
+func restObjectImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs := s.RootModule().Resources[resourceName]
+		return rs.Primary.Attributes["site"] + ":" + rs.Primary.Attributes["path"] + ":" + rs.Primary.ID, nil
+	}
+}
@@ -0,0 +1,55 @@
+package acctest
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
+)
+
+func TestAccSettingAutobackup(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingAutobackupConfig(true, "0 1 * * 1", 7, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unifi_setting_autobackup.test", "id"),
+					resource.TestCheckResourceAttr("unifi_setting_autobackup.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_autobackup.test", "cron_expr", "0 1 * * 1"),
+					resource.TestCheckResourceAttr("unifi_setting_autobackup.test", "max_files", "7"),
+					resource.TestCheckResourceAttr("unifi_setting_autobackup.test", "days", "0"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_setting_autobackup.test", plancheck.ResourceActionCreate),
+			},
+			pt.ImportStep("unifi_setting_autobackup.test"),
+			{
+				Config: testAccSettingAutobackupConfig(true, "30 2 * * *", 14, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_autobackup.test", "cron_expr", "30 2 * * *"),
+					resource.TestCheckResourceAttr("unifi_setting_autobackup.test", "max_files", "14"),
+					resource.TestCheckResourceAttr("unifi_setting_autobackup.test", "days", "30"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_setting_autobackup.test", plancheck.ResourceActionUpdate),
+			},
+			{
+				Config:      testAccSettingAutobackupConfig(true, "0 1 * *", 7, 0),
+				ExpectError: regexp.MustCompile("must be a cron expression of five fields"),
+			},
+		},
+	})
+}
+
+func testAccSettingAutobackupConfig(enabled bool, cron string, maxFiles, days int) string {
+	return fmt.Sprintf(`
+resource "unifi_setting_autobackup" "test" {
+	enabled   = %t
+	cron_expr = %q
+	max_files = %d
+	days      = %d
+}
+`, enabled, cron, maxFiles, days)
+}
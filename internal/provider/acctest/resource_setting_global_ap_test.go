@@ -0,0 +1,54 @@
+package acctest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
+)
+
+func TestAccSettingGlobalAp(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "unifi_setting_global_ap" "test" {
+	channel_width_ng          = 20
+	tx_power_mode_ng          = "low"
+	roaming_assistant_enabled = false
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unifi_setting_global_ap.test", "id"),
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "site", "default"),
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "channel_width_ng", "20"),
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "tx_power_mode_ng", "low"),
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "roaming_assistant_enabled", "false"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_setting_global_ap.test", plancheck.ResourceActionCreate),
+			},
+			pt.ImportStepWithSite("unifi_setting_global_ap.test"),
+			{
+				Config: `
+resource "unifi_setting_global_ap" "test" {
+	channel_width_ng          = 40
+	tx_power_mode_ng          = "auto"
+	channel_width_na          = 80
+	roaming_assistant_enabled = true
+	band_steering_mode        = "prefer_5g"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "channel_width_ng", "40"),
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "tx_power_mode_ng", "auto"),
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "channel_width_na", "80"),
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "roaming_assistant_enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_global_ap.test", "band_steering_mode", "prefer_5g"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_setting_global_ap.test", plancheck.ResourceActionUpdate),
+			},
+		},
+	})
+}
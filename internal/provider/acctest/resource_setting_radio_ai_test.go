@@ -0,0 +1,65 @@
+package acctest
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
+)
+
+func TestAccSettingRadioAI(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "unifi_setting_radio_ai" "test" {
+	enabled              = true
+	cron_expr            = "0 3 * * *"
+	excluded_channels_ng = [13]
+	channel_widths_na    = [20, 40]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unifi_setting_radio_ai.test", "id"),
+					resource.TestCheckResourceAttr("unifi_setting_radio_ai.test", "site", "default"),
+					resource.TestCheckResourceAttr("unifi_setting_radio_ai.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_radio_ai.test", "cron_expr", "0 3 * * *"),
+					resource.TestCheckResourceAttr("unifi_setting_radio_ai.test", "excluded_channels_ng.#", "1"),
+					resource.TestCheckTypeSetElemAttr("unifi_setting_radio_ai.test", "excluded_channels_ng.*", "13"),
+					resource.TestCheckResourceAttr("unifi_setting_radio_ai.test", "channel_widths_na.#", "2"),
+					resource.TestCheckResourceAttr("unifi_setting_radio_ai.test", "dfs", "true"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_setting_radio_ai.test", plancheck.ResourceActionCreate),
+			},
+			pt.ImportStepWithSite("unifi_setting_radio_ai.test"),
+			{
+				Config: `
+resource "unifi_setting_radio_ai" "test" {
+	enabled              = false
+	excluded_channels_na = [36]
+	dfs                  = false
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_radio_ai.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("unifi_setting_radio_ai.test", "excluded_channels_ng.#", "0"),
+					resource.TestCheckResourceAttr("unifi_setting_radio_ai.test", "excluded_channels_na.#", "1"),
+					resource.TestCheckResourceAttr("unifi_setting_radio_ai.test", "dfs", "false"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_setting_radio_ai.test", plancheck.ResourceActionUpdate),
+			},
+			{
+				Config: `
+resource "unifi_setting_radio_ai" "test" {
+	enabled              = true
+	excluded_channels_ng = [200]
+}
+`,
+				ExpectError: regexp.MustCompile(`Channel 200 is not allowed`),
+			},
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package acctest
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
+)
+
+func TestAccSettingRaw(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "unifi_setting" "test" {
+	key   = "ntp"
+	value = jsonencode({
+		setting_preference = "manual"
+		ntp_server_1       = "pool.ntp.org"
+	})
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting.test", "id", "ntp"),
+					resource.TestCheckResourceAttr("unifi_setting.test", "site", "default"),
+					resource.TestCheckResourceAttr("unifi_setting.test", "key", "ntp"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_setting.test", plancheck.ResourceActionCreate),
+			},
+			{
+				Config: `
+resource "unifi_setting" "test" {
+	key   = "ntp"
+	value = jsonencode({
+		setting_preference = "manual"
+		ntp_server_1       = "time.google.com"
+		ntp_server_2       = "pool.ntp.org"
+	})
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting.test", "key", "ntp"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_setting.test", plancheck.ResourceActionUpdate),
+			},
+			{
+				ResourceName:            "unifi_setting.test",
+				ImportState:             true,
+				ImportStateId:           "default:ntp",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+			{
+				Config: `
+resource "unifi_setting" "test" {
+	key   = "ntp"
+	value = jsonencode({ key = "mgmt" })
+}
+`,
+				ExpectError: regexp.MustCompile(`managed by the provider`),
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package acctest
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
+)
+
+func TestAccSettingSnmp(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "unifi_setting_snmp" "test" {
+	enabled   = true
+	community = "tfacc-community"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unifi_setting_snmp.test", "id"),
+					resource.TestCheckResourceAttr("unifi_setting_snmp.test", "site", "default"),
+					resource.TestCheckResourceAttr("unifi_setting_snmp.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_snmp.test", "community", "tfacc-community"),
+					resource.TestCheckResourceAttr("unifi_setting_snmp.test", "v3_enabled", "false"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_setting_snmp.test", plancheck.ResourceActionCreate),
+			},
+			pt.ImportStepWithSite("unifi_setting_snmp.test"),
+			{
+				Config: `
+resource "unifi_setting_snmp" "test" {
+	enabled             = false
+	v3_enabled          = true
+	username            = "tfacc"
+	auth_passphrase     = "tfacc-auth-passphrase"
+	passphrases_version = 1
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_snmp.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("unifi_setting_snmp.test", "v3_enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_snmp.test", "username", "tfacc"),
+					resource.TestCheckResourceAttr("unifi_setting_snmp.test", "passphrases_version", "1"),
+					resource.TestCheckNoResourceAttr("unifi_setting_snmp.test", "auth_passphrase"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_setting_snmp.test", plancheck.ResourceActionUpdate),
+			},
+			{
+				Config: `
+resource "unifi_setting_snmp" "test" {
+	v3_enabled = true
+	username   = "tfacc"
+}
+`,
+				ExpectError: regexp.MustCompile(`auth_passphrase`),
+			},
+		},
+	})
+}
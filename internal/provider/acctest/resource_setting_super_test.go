@@ -0,0 +1,164 @@
+package acctest
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	pt "github.com/filipowm/terraform-provider-unifi/internal/provider/testing"
+)
+
+func TestAccSettingSuperIdentity(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingSuperIdentityConfig("tfacc-controller"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unifi_setting_super_identity.test", "id"),
+					resource.TestCheckResourceAttr("unifi_setting_super_identity.test", "name", "tfacc-controller"),
+					resource.TestCheckResourceAttrSet("unifi_setting_super_identity.test", "hostname"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_setting_super_identity.test", plancheck.ResourceActionCreate),
+			},
+			pt.ImportStep("unifi_setting_super_identity.test"),
+			{
+				Config: testAccSettingSuperIdentityConfig("tfacc-controller-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_super_identity.test", "name", "tfacc-controller-renamed"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_setting_super_identity.test", plancheck.ResourceActionUpdate),
+			},
+		},
+	})
+}
+
+func TestAccSettingSuperSmtp(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "unifi_setting_super_smtp" "test" {
+	host = "smtp.example.com"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unifi_setting_super_smtp.test", "id"),
+					resource.TestCheckResourceAttr("unifi_setting_super_smtp.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_super_smtp.test", "host", "smtp.example.com"),
+					resource.TestCheckResourceAttr("unifi_setting_super_smtp.test", "port", "25"),
+					resource.TestCheckResourceAttr("unifi_setting_super_smtp.test", "use_ssl", "false"),
+					resource.TestCheckNoResourceAttr("unifi_setting_super_smtp.test", "username"),
+					resource.TestCheckNoResourceAttr("unifi_setting_super_smtp.test", "sender"),
+				),
+			},
+			pt.ImportStep("unifi_setting_super_smtp.test"),
+			{
+				Config: `
+resource "unifi_setting_super_smtp" "test" {
+	host     = "smtp.example.com"
+	port     = 465
+	use_ssl  = true
+	username = "alerts"
+	password = "secret-password"
+	sender   = "unifi@example.com"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_super_smtp.test", "port", "465"),
+					resource.TestCheckResourceAttr("unifi_setting_super_smtp.test", "use_ssl", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_super_smtp.test", "username", "alerts"),
+					resource.TestCheckResourceAttr("unifi_setting_super_smtp.test", "sender", "unifi@example.com"),
+					resource.TestCheckNoResourceAttr("unifi_setting_super_smtp.test", "password"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_setting_super_smtp.test", plancheck.ResourceActionUpdate),
+			},
+			pt.ImportStep("unifi_setting_super_smtp.test"),
+			{
+				Config: `
+resource "unifi_setting_super_smtp" "test" {
+	host             = "smtp.example.com"
+	port             = 465
+	use_ssl          = true
+	username         = "alerts"
+	password         = "changed-password"
+	password_version = 1
+	sender           = "unifi@example.com"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_super_smtp.test", "password_version", "1"),
+					resource.TestCheckNoResourceAttr("unifi_setting_super_smtp.test", "password"),
+				),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_setting_super_smtp.test", plancheck.ResourceActionUpdate),
+			},
+			{
+				Config: `
+resource "unifi_setting_super_smtp" "test" {
+	host     = "smtp.example.com"
+	username = "alerts"
+}
+`,
+				ExpectError: regexp.MustCompile(`Attribute "password" must be specified`),
+			},
+		},
+	})
+}
+
+func TestAccSettingSuperFwupdate(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "unifi_setting_super_fwupdate" "test" {
+	controller_channel = "release"
+	firmware_channel   = "release"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unifi_setting_super_fwupdate.test", "id"),
+					resource.TestCheckResourceAttr("unifi_setting_super_fwupdate.test", "controller_channel", "release"),
+					resource.TestCheckResourceAttr("unifi_setting_super_fwupdate.test", "firmware_channel", "release"),
+				),
+			},
+			pt.ImportStep("unifi_setting_super_fwupdate.test"),
+			{
+				Config: `
+resource "unifi_setting_super_fwupdate" "test" {
+	controller_channel = "invalid"
+}
+`,
+				ExpectError: regexp.MustCompile(`must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccSettingSuperCloudAccess(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "unifi_setting_super_cloud_access" "test" {
+	enabled = false
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unifi_setting_super_cloud_access.test", "id"),
+					resource.TestCheckResourceAttr("unifi_setting_super_cloud_access.test", "enabled", "false"),
+				),
+			},
+			pt.ImportStep("unifi_setting_super_cloud_access.test"),
+		},
+	})
+}
+
+func testAccSettingSuperIdentityConfig(name string) string {
+	return fmt.Sprintf(`
+resource "unifi_setting_super_identity" "test" {
+	name = %q
+}
+`, name)
+}
@@ -54,6 +54,28 @@ func(s *terraform.State) error {
 	})
 }
 
+func TestAccSite_clone(t *testing.T) {
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteCloneConfig("tfacc-clone-source", "tfacc-clone"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_site.clone", "description", "tfacc-clone"),
+					resource.TestCheckResourceAttr("unifi_site.clone", "source_site", "tfacc-clone-source"),
+					resource.TestCheckResourceAttrPair("data.unifi_user_group.cloned", "name", "unifi_user_group.source", "name"),
+					resource.TestCheckResourceAttr("data.unifi_user_group.cloned", "qos_rate_max_down", "2000"),
+				),
+			},
+			{
+				ResourceName:            "unifi_site.clone",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_site", "clone_objects"},
+			},
+		},
+	})
+}
+
 //nolint:unused // retained for the CheckDestroy wiring disabled above (flaky tests, see issue #480)
 func testAccCheckSiteResourceDestroy(s *terraform.State) error {
 	sites, err := testClient.ListSites(context.Background())
@@ -75,3 +97,30 @@ func testAccSiteConfig(desc string) string {
 }
 `, desc)
 }
+
+func testAccSiteCloneConfig(sourceDesc, desc string) string {
+	return fmt.Sprintf(`
+resource "unifi_site" "source" {
+	description = %q
+}
+
+resource "unifi_user_group" "source" {
+	site              = unifi_site.source.name
+	name              = "tfacc-clone"
+	qos_rate_max_down = 2000
+}
+
+resource "unifi_site" "clone" {
+	description   = %q
+	source_site   = unifi_site.source.description
+	clone_objects = ["user_groups"]
+
+	depends_on = [unifi_user_group.source]
+}
+
+data "unifi_user_group" "cloned" {
+	site = unifi_site.clone.name
+	name = unifi_user_group.source.name
+}
+`, sourceDesc, desc)
+}
@@ -101,6 +101,37 @@ func TestAccUser_fixed_ip(t *testing.T) {
 	})
 }
 
+// TestAccUser_fixedIPValidation exercises the plan-time fixed IP checks against the
+// client's network, once the network exists on the controller.
+func TestAccUser_fixedIPValidation(t *testing.T) {
+	mac, unallocateTestMac := pt.AllocateTestMac(t)
+	defer unallocateTestMac()
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet, vlan := pt.GetTestVLAN(t)
+
+	inRange, err := cidr.Host(subnet, 100)
+	if err != nil {
+		t.Error(err)
+	}
+	outside := net.ParseIP("172.16.0.10")
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfigNetwork(name, subnet, vlan),
+			},
+			{
+				Config:      testAccUserConfigFixedIP(name, subnet, vlan, mac, &inRange),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta(fmt.Sprintf("fixed IP %s is within DHCP range", inRange))),
+			},
+			{
+				Config:      testAccUserConfigFixedIP(name, subnet, vlan, mac, &outside),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta(fmt.Sprintf("fixed IP %s is not within subnet", outside))),
+			},
+		},
+	})
+}
+
 func TestAccUser_blocking(t *testing.T) {
 	mac, unallocateTestMac := pt.AllocateTestMac(t)
 	defer unallocateTestMac()
@@ -3,6 +3,7 @@
 import (
 	"fmt"
 	"net"
+	"regexp"
 	"testing"
 
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
@@ -10,6 +11,7 @@
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccWLAN_wpapsk(t *testing.T) {
@@ -29,6 +31,62 @@ func TestAccWLAN_wpapsk(t *testing.T) {
 	})
 }
 
+func TestAccWLAN_privatePresharedKeys(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet, vlan := pt.GetTestVLAN(t)
+	subnet2, vlan2 := pt.GetTestVLAN(t)
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWLANConfigPrivatePresharedKeys(name, subnet, vlan, subnet2, vlan2, "password1", "password2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "private_preshared_keys.#", "2"),
+				),
+			},
+			pt.ImportStep("unifi_wlan.test"),
+			{
+				Config: testAccWLANConfigPrivatePresharedKeys(name, subnet, vlan, subnet2, vlan2, "password1", "password3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "private_preshared_keys.#", "2"),
+				),
+			},
+			pt.ImportStep("unifi_wlan.test"),
+			{
+				Config:      testAccWLANConfigPrivatePresharedKeys(name, subnet, vlan, subnet2, vlan2, "password1", "password1"),
+				ExpectError: regexp.MustCompile("must have unique passphrases"),
+			},
+		},
+	})
+}
+
+func TestAccWLAN_dtimAndBroadcastFilter(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet, vlan := pt.GetTestVLAN(t)
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWLANConfigDtim(name, subnet, vlan, 1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "dtim_2g", "1"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "dtim_5g", "3"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "broadcast_filter_enabled", "true"),
+				),
+			},
+			pt.ImportStep("unifi_wlan.test"),
+			{
+				Config: testAccWLANConfigDtim(name, subnet, vlan, 2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "dtim_2g", "2"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "dtim_5g", "2"),
+				),
+			},
+			pt.ImportStep("unifi_wlan.test"),
+		},
+	})
+}
+
 func TestAccWLAN_open(t *testing.T) {
 	name := acctest.RandomWithPrefix("tfacc")
 	subnet, vlan := pt.GetTestVLAN(t)
@@ -147,6 +205,29 @@ func TestAccWLAN_wpaeap(t *testing.T) {
 	})
 }
 
+func TestAccWLAN_hotspot2(t *testing.T) {
+	name := acctest.RandomWithPrefix("tfacc")
+	subnet, vlan := pt.GetTestVLAN(t)
+
+	AcceptanceTest(t, AcceptanceTestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWLANConfigHotspot2(name, subnet, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("unifi_wlan.test", "hotspot2conf_id", "unifi_hotspot2_profile.test", "id"),
+				),
+			},
+			pt.ImportStep("unifi_wlan.test"),
+			{
+				// Detaching the profile keeps the WLAN.
+				Config:           testAccWLANConfigWpaeap(name, subnet, vlan),
+				Check:            resource.TestCheckResourceAttr("unifi_wlan.test", "hotspot2conf_id", ""),
+				ConfigPlanChecks: pt.CheckResourceActions("unifi_wlan.test", plancheck.ResourceActionUpdate),
+			},
+		},
+	})
+}
+
 func TestAccWLAN_wlan_band(t *testing.T) {
 	name := acctest.RandomWithPrefix("tfacc")
 	subnet, vlan := pt.GetTestVLAN(t)
@@ -387,6 +468,55 @@ func testAccWLANConfigWpapsk(name string, subnet *net.IPNet, vlan int, pmf strin
 `, name, pmf)
 }
 
+func testAccWLANConfigPrivatePresharedKeys(name string, subnet *net.IPNet, vlan int, subnet2 *net.IPNet, vlan2 int, pass1, pass2 string) string {
+	return testAccWLANBaseConfig(name, subnet, vlan) + fmt.Sprintf(`
+resource "unifi_network" "test2" {
+	name    = "%[1]s-2"
+	purpose = "corporate"
+	subnet  = "%[2]s"
+	vlan_id = "%[3]d"
+}
+
+resource "unifi_wlan" "test" {
+	name          = "%[1]s-ppsk"
+	network_id    = unifi_network.test.id
+	passphrase    = "12345678"
+	ap_group_ids  = [data.unifi_ap_group.default.id]
+	user_group_id = data.unifi_user_group.default.id
+	security      = "wpapsk"
+
+	private_preshared_keys {
+		passphrase = %[4]q
+		network_id = unifi_network.test.id
+	}
+
+	private_preshared_keys {
+		passphrase = %[5]q
+		network_id = unifi_network.test2.id
+	}
+}
+`, name, subnet2, vlan2, pass1, pass2)
+}
+
+func testAccWLANConfigDtim(name string, subnet *net.IPNet, vlan int, dtim2g, dtim5g int) string {
+	return testAccWLANBaseConfig(name, subnet, vlan) + fmt.Sprintf(`
+resource "unifi_wlan" "test" {
+	name          = "%[1]s-dtim"
+	network_id    = unifi_network.test.id
+	passphrase    = "12345678"
+	ap_group_ids  = [data.unifi_ap_group.default.id]
+	user_group_id = data.unifi_user_group.default.id
+	security      = "wpapsk"
+
+	dtim_2g = %[2]d
+	dtim_5g = %[3]d
+
+	broadcast_filter_enabled = true
+	broadcast_filter_list    = ["00:11:22:33:44:55"]
+}
+`, name, dtim2g, dtim5g)
+}
+
 func testAccWLANConfigWpaeap(name string, subnet *net.IPNet, vlan int) string {
 	return testAccWLANBaseConfig(name, subnet, vlan) + fmt.Sprintf(`
 data "unifi_radius_profile" "default" {}
@@ -397,7 +527,43 @@ func testAccWLANConfigWpaeap(name string, subnet *net.IPNet, vlan int) string {
 }
 
 resource "unifi_wlan" "test" {
-	name          = "%[1]s-wpapsk"
+	name          = "%[1]s-wpaeap"
+	network_id    = unifi_network.test.id
+	passphrase    = "12345678"
+	ap_group_ids  = [data.unifi_ap_group.default.id]
+	user_group_id = data.unifi_user_group.default.id
+	security      = "wpaeap"
+
+	radius_profile_id = data.unifi_radius_profile.default.id
+}
+`, name)
+}
+
+func testAccWLANConfigHotspot2(name string, subnet *net.IPNet, vlan int) string {
+	return testAccWLANBaseConfig(name, subnet, vlan) + fmt.Sprintf(`
+data "unifi_radius_profile" "default" {}
+
+resource "unifi_setting_radius" "this" {
+	enabled = true
+	secret  = "securepw"
+}
+
+resource "unifi_hotspot2_profile" "test" {
+	name         = "%[1]s"
+	network_type = "free-public"
+
+	venue_names = [{
+		name = "Test Venue"
+	}]
+
+	nai_realms = [{
+		name       = "example.com"
+		eap_method = "eap-ttls"
+	}]
+}
+
+resource "unifi_wlan" "test" {
+	name          = "%[1]s-wpaeap"
 	network_id    = unifi_network.test.id
 	passphrase    = "12345678"
 	ap_group_ids  = [data.unifi_ap_group.default.id]
@@ -405,6 +571,7 @@ func testAccWLANConfigWpaeap(name string, subnet *net.IPNet, vlan int) string {
 	security      = "wpaeap"
 
 	radius_profile_id = data.unifi_radius_profile.default.id
+	hotspot2conf_id   = unifi_hotspot2_profile.test.id
 }
 `, name)
 }
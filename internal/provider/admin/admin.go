@@ -0,0 +1,96 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/filipowm/go-unifi/unifi"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+)
+
+const (
+	roleAdmin    = "admin"
+	roleReadOnly = "readonly"
+)
+
+// adminInfo is an administrator of a site, as returned by the site manager.
+// Role and permissions are those the administrator has on that site.
+type adminInfo struct {
+	ID                  string   `json:"_id,omitempty"`
+	Name                string   `json:"name,omitempty"`
+	Email               string   `json:"email,omitempty"`
+	Role                string   `json:"role,omitempty"`
+	Permissions         []string `json:"permissions"`
+	RequiresNewPassword bool     `json:"requires_new_password"`
+	IsSuper             bool     `json:"is_super,omitempty"`
+
+	// Password is only sent, the controller never returns it.
+	Password *string `json:"-"`
+	// written marks an admin returned by a write, whose Password is that of
+	// the configuration rather than unknown.
+	written bool
+}
+
+// sitemgr runs a command of the site manager of a site, which manages the
+// administrators of sites.
+func sitemgr(ctx context.Context, c *base.Client, site string, cmd map[string]interface{}) ([]adminInfo, error) {
+	var resp base.RestResponse[adminInfo]
+	if err := c.Do(ctx, http.MethodPost, fmt.Sprintf("s/%s/cmd/sitemgr", site), cmd, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// getAdmin returns the administrator of a site with the given ID, or
+// unifi.ErrNotFound when the administrator has no access to the site.
+func getAdmin(ctx context.Context, c *base.Client, site, id string) (*adminInfo, error) {
+	admins, err := sitemgr(ctx, c, site, map[string]interface{}{"cmd": "get-admins"})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range admins {
+		if a.ID == id {
+			return &a, nil
+		}
+	}
+	return nil, unifi.ErrNotFound
+}
+
+// findAdmin returns the administrator of a site with the given name, or
+// unifi.ErrNotFound.
+func findAdmin(ctx context.Context, c *base.Client, site, name string) (*adminInfo, error) {
+	admins, err := sitemgr(ctx, c, site, map[string]interface{}{"cmd": "get-admins"})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range admins {
+		if a.Name == name {
+			return &a, nil
+		}
+	}
+	return nil, unifi.ErrNotFound
+}
+
+// grantAdmin sets the role and permissions of an administrator on a site,
+// granting access to the site when the administrator has none.
+func grantAdmin(ctx context.Context, c *base.Client, site, id, role string, permissions []string) error {
+	_, err := sitemgr(ctx, c, site, map[string]interface{}{
+		"cmd":         "grant-admin",
+		"admin":       id,
+		"role":        role,
+		"permissions": permissions,
+	})
+	return err
+}
+
+// revokeAdmin revokes the access of an administrator to a site. The
+// controller deletes local administrators left without access to any site.
+func revokeAdmin(ctx context.Context, c *base.Client, site, id string) error {
+	_, err := sitemgr(ctx, c, site, map[string]interface{}{
+		"cmd":   "revoke-admin",
+		"admin": id,
+	})
+	return err
+}
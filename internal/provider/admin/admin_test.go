@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+func TestAdminModel_Merge(t *testing.T) {
+	ctx := context.Background()
+	password := "secret"
+	m := &adminModel{Password: types.StringValue(password)}
+
+	// Reads leave the password, which the controller never returns, alone.
+	diags := m.Merge(ctx, &adminInfo{ID: "1", Name: "jane", Role: roleReadOnly})
+	require.False(t, diags.HasError())
+	assert.Equal(t, "1", m.ID.ValueString())
+	assert.True(t, m.Email.IsNull())
+	assert.Equal(t, roleReadOnly, m.Role.ValueString())
+	assert.Empty(t, m.Permissions.Elements())
+	assert.Equal(t, password, m.Password.ValueString())
+
+	// Writes take the password of the configuration.
+	diags = m.Merge(ctx, &adminInfo{ID: "1", Name: "jane", Email: "jane@example.com", Role: roleAdmin, Permissions: []string{"API_DEVICE_ADOPT"}, written: true})
+	require.False(t, diags.HasError())
+	assert.Equal(t, "jane@example.com", m.Email.ValueString())
+	assert.Len(t, m.Permissions.Elements(), 1)
+	assert.True(t, m.Password.IsNull())
+}
+
+func TestAdminModel_AsUnifiModel(t *testing.T) {
+	ctx := context.Background()
+	m := &adminModel{
+		Name:                types.StringValue("jane"),
+		Role:                types.StringValue(roleAdmin),
+		Permissions:         ut.EmptySet(types.StringType),
+		Password:            types.StringNull(),
+		RequiresNewPassword: types.BoolValue(true),
+	}
+
+	model, diags := m.AsUnifiModel(ctx)
+	require.False(t, diags.HasError())
+	a, ok := model.(*adminInfo)
+	require.True(t, ok)
+	assert.Equal(t, "jane", a.Name)
+	assert.NotNil(t, a.Permissions)
+	assert.Nil(t, a.Password)
+	assert.True(t, a.RequiresNewPassword)
+}
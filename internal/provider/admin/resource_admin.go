@@ -0,0 +1,239 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+// adminModel represents the data model for a local UniFi administrator.
+type adminModel struct {
+	base.Model
+	Name                types.String `tfsdk:"name"`
+	Email               types.String `tfsdk:"email"`
+	Role                types.String `tfsdk:"role"`
+	Permissions         types.Set    `tfsdk:"permissions"`
+	Password            types.String `tfsdk:"password"`
+	RequiresNewPassword types.Bool   `tfsdk:"requires_new_password"`
+}
+
+// AsUnifiModel converts the Terraform model to the site manager's model.
+func (m *adminModel) AsUnifiModel(ctx context.Context) (interface{}, diag.Diagnostics) {
+	permissions := []string{}
+	diags := m.Permissions.ElementsAs(ctx, &permissions, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &adminInfo{
+		ID:                  m.ID.ValueString(),
+		Name:                m.Name.ValueString(),
+		Email:               m.Email.ValueString(),
+		Role:                m.Role.ValueString(),
+		Permissions:         permissions,
+		RequiresNewPassword: m.RequiresNewPassword.ValueBool(),
+		Password:            m.Password.ValueStringPointer(),
+	}, diags
+}
+
+// Merge updates the Terraform model with values from the site manager's model.
+func (m *adminModel) Merge(ctx context.Context, other interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	a, ok := other.(*adminInfo)
+	if !ok {
+		diags.AddError("Invalid model type", "Expected *adminInfo")
+		return diags
+	}
+
+	m.ID = types.StringValue(a.ID)
+	m.Name = types.StringValue(a.Name)
+	m.Email = ut.StringOrNull(a.Email)
+	m.Role = types.StringValue(a.Role)
+	m.Permissions, diags = types.SetValueFrom(ctx, types.StringType, append([]string{}, a.Permissions...))
+	m.RequiresNewPassword = types.BoolValue(a.RequiresNewPassword)
+	if a.written {
+		m.Password = types.StringPointerValue(a.Password)
+	}
+	return diags
+}
+
+var (
+	_ resource.Resource                = &adminResource{}
+	_ resource.ResourceWithConfigure   = &adminResource{}
+	_ resource.ResourceWithImportState = &adminResource{}
+	_ base.Resource                    = &adminResource{}
+)
+
+type adminResource struct {
+	*base.GenericResource[*adminModel]
+}
+
+// NewAdminResource creates a new instance of the admin resource.
+func NewAdminResource() resource.Resource {
+	return &adminResource{
+		GenericResource: base.NewGenericResource(
+			"unifi_admin",
+			func() *adminModel { return &adminModel{} },
+			base.ResourceFunctions{
+				Read: func(ctx context.Context, client *base.Client, site, id string) (interface{}, error) {
+					return getAdmin(ctx, client, site, id)
+				},
+				Create: createAdmin,
+				Update: updateAdmin,
+				Delete: func(ctx context.Context, client *base.Client, site, id string) error {
+					return revokeAdmin(ctx, client, site, id)
+				},
+			},
+		),
+	}
+}
+
+// createAdmin creates a local administrator with a password or, without one,
+// invites the administrator by email. An administrator of the same name must
+// not exist yet, so the resource never takes over an existing administrator;
+// such an administrator is imported instead.
+func createAdmin(ctx context.Context, client *base.Client, site string, model interface{}) (interface{}, error) {
+	a, ok := model.(*adminInfo)
+	if !ok {
+		return nil, fmt.Errorf("unexpected model type %T, expected *adminInfo", model)
+	}
+	cmd := map[string]interface{}{
+		"cmd":         "invite-admin",
+		"name":        a.Name,
+		"email":       a.Email,
+		"role":        a.Role,
+		"permissions": a.Permissions,
+	}
+	if a.Password != nil {
+		cmd["cmd"] = "create-admin"
+		cmd["x_password"] = *a.Password
+		cmd["requires_new_password"] = a.RequiresNewPassword
+	} else if a.Email == "" {
+		return nil, errors.New("an email is required to invite an admin without a password")
+	}
+	existing, err := findAdmin(ctx, client, site, a.Name)
+	if err == nil {
+		return nil, fmt.Errorf("admin %q already exists with ID %s, import it instead", a.Name, existing.ID)
+	}
+	if !errors.Is(err, unifi.ErrNotFound) {
+		return nil, err
+	}
+	admins, err := sitemgr(ctx, client, site, cmd)
+	if err != nil {
+		return nil, err
+	}
+	var created *adminInfo
+	if len(admins) > 0 && admins[0].ID != "" {
+		created, err = getAdmin(ctx, client, site, admins[0].ID)
+	} else {
+		// The name was checked to be free above, so the administrator of
+		// that name is the one just created.
+		created, err = findAdmin(ctx, client, site, a.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	created.Password = a.Password
+	created.written = true
+	return created, nil
+}
+
+// updateAdmin updates the administrator's account, then its role and
+// permissions on the site.
+func updateAdmin(ctx context.Context, client *base.Client, site string, model interface{}) (interface{}, error) {
+	a, ok := model.(*adminInfo)
+	if !ok {
+		return nil, fmt.Errorf("unexpected model type %T, expected *adminInfo", model)
+	}
+	cmd := map[string]interface{}{
+		"cmd":                   "update-admin",
+		"admin":                 a.ID,
+		"name":                  a.Name,
+		"email":                 a.Email,
+		"requires_new_password": a.RequiresNewPassword,
+	}
+	if a.Password != nil {
+		cmd["x_password"] = *a.Password
+	}
+	if _, err := sitemgr(ctx, client, site, cmd); err != nil {
+		return nil, err
+	}
+	if err := grantAdmin(ctx, client, site, a.ID, a.Role, a.Permissions); err != nil {
+		return nil, err
+	}
+	updated, err := getAdmin(ctx, client, site, a.ID)
+	if err != nil {
+		return nil, err
+	}
+	updated.Password = a.Password
+	updated.written = true
+	return updated, nil
+}
+
+// Schema defines the schema for the resource.
+func (r *adminResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_admin` resource manages local administrators of the UniFi controller and their role on a site.\n\n" +
+			"An administrator created with a `password` can log in right away. Without a password, the administrator is invited " +
+			"by email to set one. Use `unifi_admin_site_access` to give the administrator access to further sites.\n\n" +
+			"Destroying the resource revokes the administrator's access to its `site` only. Access to other sites, e.g. granted by " +
+			"`unifi_admin_site_access`, is kept, and the controller deletes the administrator only once no access to any site is left.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ut.ID(),
+			"site": ut.SiteAttribute("The name of the UniFi site the administrator is created in and whose `role` and `permissions` " +
+				"are managed, either the internal site name or its description. If not specified, the default site will be used."),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name the administrator logs in with.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the administrator. Required when no `password` is set, to send the invitation to.",
+				Optional:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role of the administrator on the site, `admin` for full management or `readonly` to view only.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(roleAdmin),
+				Validators: []validator.String{
+					stringvalidator.OneOf(roleAdmin, roleReadOnly),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: "Additional permissions of the administrator on the site, e.g. `API_DEVICE_ADOPT` or `API_DEVICE_RESTART` " +
+					"for read-only administrators allowed to adopt or restart devices.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     ut.DefaultEmptySet(types.StringType),
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the administrator. When not set, the administrator is invited by email instead.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"requires_new_password": schema.BoolAttribute{
+				MarkdownDescription: "Whether the administrator must change the password on the next login.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
@@ -0,0 +1,159 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+// adminSiteAccessModel represents the access of an administrator to a site.
+// Its ID is the ID of the administrator.
+type adminSiteAccessModel struct {
+	base.Model
+	AdminID     types.String `tfsdk:"admin_id"`
+	Role        types.String `tfsdk:"role"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+// AsUnifiModel converts the Terraform model to the site manager's model.
+func (m *adminSiteAccessModel) AsUnifiModel(ctx context.Context) (interface{}, diag.Diagnostics) {
+	permissions := []string{}
+	diags := m.Permissions.ElementsAs(ctx, &permissions, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &adminInfo{
+		ID:          m.AdminID.ValueString(),
+		Role:        m.Role.ValueString(),
+		Permissions: permissions,
+	}, diags
+}
+
+// Merge updates the Terraform model with values from the site manager's model.
+func (m *adminSiteAccessModel) Merge(ctx context.Context, other interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	a, ok := other.(*adminInfo)
+	if !ok {
+		diags.AddError("Invalid model type", "Expected *adminInfo")
+		return diags
+	}
+
+	m.ID = types.StringValue(a.ID)
+	m.AdminID = types.StringValue(a.ID)
+	m.Role = types.StringValue(a.Role)
+	m.Permissions, diags = types.SetValueFrom(ctx, types.StringType, append([]string{}, a.Permissions...))
+	return diags
+}
+
+var (
+	_ resource.Resource                = &adminSiteAccessResource{}
+	_ resource.ResourceWithConfigure   = &adminSiteAccessResource{}
+	_ resource.ResourceWithImportState = &adminSiteAccessResource{}
+	_ base.Resource                    = &adminSiteAccessResource{}
+)
+
+type adminSiteAccessResource struct {
+	*base.GenericResource[*adminSiteAccessModel]
+}
+
+// NewAdminSiteAccessResource creates a new instance of the admin site access
+// resource.
+func NewAdminSiteAccessResource() resource.Resource {
+	grant := func(ctx context.Context, client *base.Client, site string, model interface{}) (interface{}, error) {
+		a, ok := model.(*adminInfo)
+		if !ok {
+			return nil, fmt.Errorf("unexpected model type %T, expected *adminInfo", model)
+		}
+		if err := grantAdmin(ctx, client, site, a.ID, a.Role, a.Permissions); err != nil {
+			return nil, err
+		}
+		return getAdmin(ctx, client, site, a.ID)
+	}
+	return &adminSiteAccessResource{
+		GenericResource: base.NewGenericResource(
+			"unifi_admin_site_access",
+			func() *adminSiteAccessModel { return &adminSiteAccessModel{} },
+			base.ResourceFunctions{
+				Read: func(ctx context.Context, client *base.Client, site, id string) (interface{}, error) {
+					return getAdmin(ctx, client, site, id)
+				},
+				// Create refuses to take over an existing access, like the
+				// one to the site of the administrator's unifi_admin resource,
+				// which would otherwise be revoked on destroy.
+				Create: func(ctx context.Context, client *base.Client, site string, model interface{}) (interface{}, error) {
+					a, ok := model.(*adminInfo)
+					if !ok {
+						return nil, fmt.Errorf("unexpected model type %T, expected *adminInfo", model)
+					}
+					_, err := getAdmin(ctx, client, site, a.ID)
+					if err == nil {
+						return nil, fmt.Errorf("administrator %s already has access to site %s, e.g. as the site of its unifi_admin resource; "+
+							"manage that access there or import it instead", a.ID, site)
+					}
+					if !errors.Is(err, unifi.ErrNotFound) {
+						return nil, err
+					}
+					return grant(ctx, client, site, model)
+				},
+				Update: grant,
+				Delete: func(ctx context.Context, client *base.Client, site, id string) error {
+					return revokeAdmin(ctx, client, site, id)
+				},
+			},
+		),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *adminSiteAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_admin_site_access` resource grants an existing administrator access to a site, with a role and " +
+			"permissions specific to that site.\n\n" +
+			"The administrator must not have access to the site yet, so the site of its `unifi_admin` resource cannot be used; " +
+			"import an existing access to manage it instead.\n\n" +
+			"Destroying the resource revokes the access. The controller deletes administrators left without access to any site.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ut.ID("The ID of the administrator."),
+			"site": ut.SiteAttribute("The name of the UniFi site to grant access to, either the internal site name or its description. " +
+				"If not specified, the default site will be used."),
+			"admin_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the administrator, e.g. the `id` of a `unifi_admin` resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role of the administrator on the site, `admin` for full management or `readonly` to view only.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(roleAdmin),
+				Validators: []validator.String{
+					stringvalidator.OneOf(roleAdmin, roleReadOnly),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: "Additional permissions of the administrator on the site, e.g. `API_DEVICE_ADOPT` or `API_DEVICE_RESTART`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             ut.DefaultEmptySet(types.StringType),
+			},
+		},
+	}
+}
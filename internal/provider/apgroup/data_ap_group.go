@@ -97,7 +97,11 @@ func (d *apGroupDatasource) Read(ctx context.Context, req datasource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	site := d.client.ResolveSite(&state)
+	site, diags := d.client.ResolveSite(&state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	groups, err := d.client.ListAPGroup(ctx, site)
 	if err != nil {
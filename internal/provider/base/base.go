@@ -7,7 +7,10 @@
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
 )
 
 type SiteAware interface {
@@ -36,6 +39,22 @@ type ResourceModel interface {
 	AsUnifiModel(context.Context) (interface{}, diag.Diagnostics)
 }
 
+// WriteOnlyModel is implemented by resource models with write-only attributes.
+// Their values are never part of the plan, so they are loaded from the
+// configuration before the model is written to the controller.
+type WriteOnlyModel interface {
+	LoadWriteOnly(ctx context.Context, config tfsdk.Config) diag.Diagnostics
+}
+
+// WriteOnlyVersionModel is implemented by models whose write-only attributes
+// are sent again when a version attribute changes. The version is not stored
+// on the controller, so it is kept from the plan on update.
+type WriteOnlyVersionModel interface {
+	WriteOnlyModel
+	GetWriteOnlyVersion() types.Int64
+	SetWriteOnlyVersion(types.Int64)
+}
+
 // ResourceModel defines the interface that all setting models must implement.
 type DatasourceModel interface {
 	SiteAware
@@ -44,7 +63,7 @@ type DatasourceModel interface {
 
 type Model struct {
 	ID   types.String `tfsdk:"id"`
-	Site types.String `tfsdk:"site"`
+	Site ut.SiteValue `tfsdk:"site"`
 }
 
 func (b *Model) GetID() string {
@@ -64,11 +83,13 @@ func (b *Model) GetSite() string {
 }
 
 func (b *Model) GetRawSite() types.String {
-	return b.Site
+	return b.Site.StringValue
 }
 
+// SetSite stores the internal site name. A configured description of the
+// same site is kept in state by the semantic equality of ut.SiteValue.
 func (b *Model) SetSite(site string) {
-	b.Site = types.StringValue(site)
+	b.Site = ut.NewSiteValue(site)
 }
 
 func ConfigureDatasource(base Resource, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -4,6 +4,7 @@
 	"bytes"
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -18,7 +19,6 @@
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
@@ -86,13 +86,71 @@ func NewClient(cfg *ClientConfig) (*Client, error) {
 		Client:  NewRetryableUnifiClient(unifiClient),
 		Site:    cfg.Site,
 		Version: version.Must(version.NewVersion(unifiClient.Version())),
+
+		downloader: newDownloader(cfg),
+		url:        cfg.URL,
 	}
 	if cfg.APIKey != "" && !c.SupportsAPIKeyAuthentication() {
 		return nil, fmt.Errorf("API key authentication is not supported on this controller version: %s, you must be on %s or higher", c.Version, ControllerVersionAPIKeyAuth)
 	}
+	c.loadSites()
+	c.Site, err = c.ResolveSiteName(cfg.Site)
+	if err != nil {
+		return nil, err
+	}
 	return c, nil
 }
 
+// loadedSites holds the controller URLs whose sites have been registered, so
+// sites are listed once per run even though both provider implementations
+// create their own client.
+var loadedSites sync.Map
+
+// loadSites registers the sites of the controller to resolve site
+// descriptions to internal site names, unless they are already registered.
+func (c *Client) loadSites() {
+	if _, loaded := loadedSites.LoadOrStore(c.url, true); loaded {
+		return
+	}
+	if err := c.registerSites(); err != nil {
+		loadedSites.Delete(c.url)
+		log.Printf("[WARN] Unable to list sites, site descriptions will not be resolved to site names: %v", err)
+	}
+}
+
+// registerSites lists the sites of the controller and registers them. When
+// sites cannot be listed, site values are passed to the controller unchanged.
+func (c *Client) registerSites() error {
+	sites, err := c.ListSites(context.Background())
+	if err != nil {
+		return err
+	}
+	names := make(map[string]string, len(sites))
+	for _, s := range sites {
+		names[s.Name] = s.Description
+	}
+	utils.RegisterSites(c.url, names)
+	return nil
+}
+
+// RegisterSite registers a site created during the run, so it can be
+// referenced by its description too.
+func (c *Client) RegisterSite(name, description string) {
+	utils.RegisterSites(c.url, map[string]string{name: description})
+}
+
+// ResolveSiteName returns the internal name of a site of the controller, given
+// either by its internal name or by its description. The sites are listed
+// again before a site is reported as not found, as it may have been created
+// since they were listed.
+func (c *Client) ResolveSiteName(site string) (string, error) {
+	name, err := utils.ResolveControllerSiteName(c.url, site)
+	if errors.Is(err, utils.ErrSiteNotFound) && c.registerSites() == nil {
+		name, err = utils.ResolveControllerSiteName(c.url, site)
+	}
+	return name, err
+}
+
 func NewRetryableUnifiClient(client unifi.Client) unifi.Client {
 	return &RetryableUnifiClient{
 		Client:     client,
@@ -131,25 +189,52 @@ type Client struct {
 	unifi.Client
 	Site    string
 	Version *version.Version
+
+	downloader *downloader
+	url        string
 }
 
-func (c *Client) ResolveSite(res SiteAware) string {
+// ResolveSite returns the internal name of the site of the resource, given
+// either by its internal name or by its description, falling back to the
+// provider site when none is set.
+func (c *Client) ResolveSite(res SiteAware) (string, diag.Diagnostics) {
 	if res == nil || ut.IsEmptyString(res.GetRawSite()) {
-		return c.Site
+		return c.Site, nil
 	}
-	return res.GetSite()
+	return c.resolveSiteName(res.GetSite())
+}
+
+// SiteFromResourceData returns the internal name of the site of an SDK
+// resource, falling back to the provider site when none is set.
+func (c *Client) SiteFromResourceData(d interface{ Get(string) interface{} }) (string, error) {
+	site, _ := d.Get("site").(string)
+	if site == "" {
+		return c.Site, nil
+	}
+	return c.ResolveSiteName(site)
 }
 
 func (c *Client) ResolveSiteFromConfig(ctx context.Context, config tfsdk.Config) (string, diag.Diagnostics) {
-	var site types.String
+	var site ut.SiteValue
 	diags := config.GetAttribute(ctx, path.Root("site"), &site)
 	if diags.HasError() {
 		return "", diags
 	}
-	if ut.IsEmptyString(site) {
+	if ut.IsEmptyString(site.StringValue) {
 		return c.Site, diags
 	}
-	return site.ValueString(), diags
+	name, resolveDiags := c.resolveSiteName(site.ValueString())
+	diags.Append(resolveDiags...)
+	return name, diags
+}
+
+func (c *Client) resolveSiteName(site string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	name, err := c.ResolveSiteName(site)
+	if err != nil {
+		diags.AddAttributeError(path.Root("site"), "Invalid site", err.Error())
+	}
+	return name, diags
 }
 
 func CreateHTTPTransport(insecure bool) http.RoundTripper {
@@ -0,0 +1,183 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"strings"
+	"sync"
+)
+
+// downloader fetches files the controller serves outside of its API, like
+// backups. go-unifi only decodes JSON responses, so the downloader keeps a
+// session of its own, created on first use.
+//
+// The session of go-unifi cannot be reused: its HTTP client, cookie jar and
+// CSRF token are unexported, and its Do method only sends requests to API
+// paths whose responses it decodes as JSON. The downloader therefore logs in
+// with the same credentials and renews its session when the controller
+// rejects it.
+type downloader struct {
+	url      string
+	username string
+	password string
+	apiKey   string
+	client   *http.Client
+
+	mu        sync.Mutex
+	loggedIn  bool
+	unifiOS   bool
+	csrfToken string
+}
+
+func newDownloader(cfg *ClientConfig) *downloader {
+	transport := CreateHTTPTransport(cfg.Insecure)
+	if cfg.HTTPConfigurer != nil {
+		transport = cfg.HTTPConfigurer()
+	}
+	jar, _ := cookiejar.New(nil)
+	return &downloader{
+		url:      strings.TrimSuffix(cfg.URL, "/"),
+		username: cfg.Username,
+		password: cfg.Password,
+		apiKey:   cfg.APIKey,
+		client:   &http.Client{Transport: transport, Jar: jar},
+	}
+}
+
+// Download writes the file the controller serves at the given path, e.g.
+// `/dl/backup/9.0.114.unf`, to w.
+func (c *Client) Download(ctx context.Context, filePath string, w io.Writer) error {
+	if c.downloader == nil {
+		return errors.New("downloading files is not supported by this client")
+	}
+	return c.downloader.download(ctx, filePath, w)
+}
+
+func (d *downloader) download(ctx context.Context, filePath string, w io.Writer) error {
+	resp, err := d.get(ctx, filePath)
+	if err != nil {
+		return err
+	}
+	if d.apiKey == "" && (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden) {
+		// The session expired, e.g. because the controller restarted, so log
+		// in again and retry once.
+		resp.Body.Close()
+		d.logout()
+		resp, err = d.get(ctx, filePath)
+		if err != nil {
+			return err
+		}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download %s: %s", filePath, resp.Status)
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("failed to download %s: %w", filePath, err)
+	}
+	return nil
+}
+
+// get requests the file at the given path, logging in first if needed.
+func (d *downloader) get(ctx context.Context, filePath string) (*http.Response, error) {
+	if err := d.login(ctx); err != nil {
+		return nil, err
+	}
+	prefix := ""
+	if d.unifiOS {
+		prefix = "/proxy/network"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.url+prefix+"/"+strings.TrimPrefix(filePath, "/"), nil)
+	if err != nil {
+		return nil, err
+	}
+	d.authorize(req)
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", filePath, err)
+	}
+	return resp, nil
+}
+
+func (d *downloader) authorize(req *http.Request) {
+	if d.apiKey != "" {
+		req.Header.Set("X-API-KEY", d.apiKey)
+	}
+	if d.csrfToken != "" {
+		req.Header.Set("X-CSRF-Token", d.csrfToken)
+	}
+}
+
+// login creates the session of the downloader. API keys are only supported by
+// UniFi OS, which needs no session. Otherwise, the UniFi OS login is tried
+// first, falling back to that of standalone controllers.
+func (d *downloader) login(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.loggedIn {
+		return nil
+	}
+	if d.apiKey != "" {
+		d.unifiOS = true
+		d.loggedIn = true
+		return nil
+	}
+	resp, err := d.postLogin(ctx, "/api/auth/login")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		d.unifiOS = true
+		d.csrfToken = resp.Header.Get("X-CSRF-Token")
+	case http.StatusNotFound:
+		legacy, err := d.postLogin(ctx, "/api/login")
+		if err != nil {
+			return err
+		}
+		defer legacy.Body.Close()
+		if legacy.StatusCode != http.StatusOK {
+			return fmt.Errorf("failed to log in to download files: %s", legacy.Status)
+		}
+	default:
+		return fmt.Errorf("failed to log in to download files: %s", resp.Status)
+	}
+	d.loggedIn = true
+	return nil
+}
+
+// logout drops the session of the downloader, so the next download logs in
+// again.
+func (d *downloader) logout() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.loggedIn = false
+	d.csrfToken = ""
+}
+
+func (d *downloader) postLogin(ctx context.Context, loginPath string) (*http.Response, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"username": d.username,
+		"password": d.password,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url+loginPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to log in to download files: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,107 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownload_unifiOS(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/auth/login", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "TOKEN", Value: "session", Path: "/"})
+		w.Header().Set("X-CSRF-Token", "csrf")
+	})
+	mux.HandleFunc("/proxy/network/dl/backup/9.0.114.unf", func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("TOKEN")
+		if err != nil || cookie.Value != "session" || r.Header.Get("X-CSRF-Token") != "csrf" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte("backup"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := &Client{downloader: newDownloader(&ClientConfig{URL: server.URL + "/", Username: "admin", Password: "secret"})}
+	var buf bytes.Buffer
+	require.NoError(t, c.Download(context.Background(), "/dl/backup/9.0.114.unf", &buf))
+	assert.Equal(t, "backup", buf.String())
+}
+
+func TestDownload_legacy(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/login", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "unifises", Value: "session", Path: "/"})
+	})
+	mux.HandleFunc("/dl/backup/8.0.7.unf", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := r.Cookie("unifises"); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte("backup"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := &Client{downloader: newDownloader(&ClientConfig{URL: server.URL, Username: "admin", Password: "secret"})}
+	var buf bytes.Buffer
+	require.NoError(t, c.Download(context.Background(), "/dl/backup/8.0.7.unf", &buf))
+	assert.Equal(t, "backup", buf.String())
+}
+
+func TestDownload_expiredSession(t *testing.T) {
+	var logins, session atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/login", func(w http.ResponseWriter, r *http.Request) {
+		logins.Add(1)
+		http.SetCookie(w, &http.Cookie{Name: "unifises", Value: fmt.Sprint(session.Load()), Path: "/"})
+	})
+	mux.HandleFunc("/dl/backup/8.0.7.unf", func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("unifises"); err != nil || cookie.Value != fmt.Sprint(session.Load()) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte("backup"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := &Client{downloader: newDownloader(&ClientConfig{URL: server.URL, Username: "admin", Password: "secret"})}
+	var buf bytes.Buffer
+	require.NoError(t, c.Download(context.Background(), "/dl/backup/8.0.7.unf", &buf))
+	assert.Equal(t, int32(1), logins.Load())
+
+	// The controller drops the session, so the downloader logs in again.
+	session.Add(1)
+	buf.Reset()
+	require.NoError(t, c.Download(context.Background(), "/dl/backup/8.0.7.unf", &buf))
+	assert.Equal(t, "backup", buf.String())
+	assert.Equal(t, int32(2), logins.Load())
+}
+
+func TestDownload_apiKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/proxy/network/dl/backup/9.0.114.unf" || r.Header.Get("X-API-KEY") != "key" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("backup"))
+	}))
+	defer server.Close()
+
+	c := &Client{downloader: newDownloader(&ClientConfig{URL: server.URL, APIKey: "key"})}
+	var buf bytes.Buffer
+	require.NoError(t, c.Download(context.Background(), "dl/backup/9.0.114.unf", &buf))
+	assert.Equal(t, "backup", buf.String())
+
+	err := c.Download(context.Background(), "dl/backup/missing.unf", &buf)
+	assert.ErrorContains(t, err, "404")
+}
@@ -3,11 +3,13 @@
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/types"
 
+	"github.com/filipowm/go-unifi/unifi"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -43,6 +45,32 @@ func (v Features) IsUnavailable(feature string) bool {
 	return true
 }
 
+// NewFeatures returns the features of a site as listed by the controller.
+func NewFeatures(features []unifi.DescribedFeature) Features {
+	f := make(Features, len(features))
+	for _, feature := range features {
+		if feature.FeatureExists {
+			f[feature.Name] = featureEnabled
+		} else {
+			f[feature.Name] = featureDisabled
+		}
+	}
+	return f
+}
+
+// Names returns the sorted names of the available features, or only of the
+// enabled ones.
+func (v Features) Names(enabledOnly bool) []string {
+	names := make([]string, 0, len(v))
+	for name := range v {
+		if !enabledOnly || v.IsEnabled(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 type featureEnabledValidator struct {
 	client *Client
 	cache  map[string]Features
@@ -63,20 +91,12 @@ func (v *featureEnabledValidator) getFeatures(ctx context.Context, site string)
 	if v.cache[site] != nil {
 		return v.cache[site]
 	}
-	cache := make(map[string]featureStatus)
 	features, err := v.client.ListFeatures(ctx, site)
 	if err != nil {
 		// Return an empty Features map instead of nil to avoid potential nil pointer dereference
 		return Features{}
 	}
-	for _, feature := range features {
-		if feature.FeatureExists {
-			cache[feature.Name] = featureEnabled
-		} else {
-			cache[feature.Name] = featureDisabled
-		}
-	}
-	v.cache[site] = cache
+	v.cache[site] = NewFeatures(features)
 	return v.cache[site]
 }
 
@@ -150,6 +150,19 @@ func TestFeaturesIsUnavailable(t *testing.T) {
 	}
 }
 
+// TestFeaturesNames tests the Names method of Features.
+func TestFeaturesNames(t *testing.T) {
+	features := NewFeatures([]unifi.DescribedFeature{
+		{Name: "feature2", FeatureExists: true},
+		{Name: "feature3", FeatureExists: false},
+		{Name: "feature1", FeatureExists: true},
+	})
+
+	assert.Equal(t, []string{"feature1", "feature2", "feature3"}, features.Names(false))
+	assert.Equal(t, []string{"feature1", "feature2"}, features.Names(true))
+	assert.Empty(t, Features{}.Names(false))
+}
+
 func newTestClient(mock *MockUnifiClient) *Client {
 	return &Client{
 		Client: mock,
@@ -0,0 +1,286 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+// ListItem holds the properties of a listed object the common list filters
+// match against. Objects without a VLAN report 0.
+type ListItem struct {
+	Name    string
+	Purpose string
+	VLAN    int
+	Enabled bool
+}
+
+// ListFilters configures which common filters, besides `name_regex`, a list
+// data source supports.
+type ListFilters struct {
+	// Purpose is the description of the `purpose` filter, whose meaning
+	// depends on the listed object. Empty disables the filter.
+	Purpose string
+	VLAN    bool
+	Enabled bool
+}
+
+// ListFilter is a parsed `filter` attribute. Unset fields match every item.
+type ListFilter struct {
+	NameRegex *regexp.Regexp
+	Purpose   string
+	VLANMin   *int
+	VLANMax   *int
+	Enabled   *bool
+}
+
+// Matches reports whether the item matches every filter set.
+func (f ListFilter) Matches(item ListItem) bool {
+	switch {
+	case f.NameRegex != nil && !f.NameRegex.MatchString(item.Name):
+		return false
+	case f.Purpose != "" && item.Purpose != f.Purpose:
+		return false
+	case f.VLANMin != nil && item.VLAN < *f.VLANMin:
+		return false
+	case f.VLANMax != nil && item.VLAN > *f.VLANMax:
+		return false
+	case f.Enabled != nil && item.Enabled != *f.Enabled:
+		return false
+	}
+	return true
+}
+
+// ListFilterFromObject parses the `filter` attribute of a list data source. A
+// null filter matches every item.
+func ListFilterFromObject(filter types.Object) (ListFilter, diag.Diagnostics) {
+	var (
+		f     ListFilter
+		diags diag.Diagnostics
+	)
+	if filter.IsNull() || filter.IsUnknown() {
+		return f, diags
+	}
+	attrs := filter.Attributes()
+	if nameRegex, ok := attrs["name_regex"].(types.String); ok && nameRegex.ValueString() != "" {
+		re, err := regexp.Compile(nameRegex.ValueString())
+		if err != nil {
+			diags.AddError("Invalid name_regex", err.Error())
+			return f, diags
+		}
+		f.NameRegex = re
+	}
+	if purpose, ok := attrs["purpose"].(types.String); ok {
+		f.Purpose = purpose.ValueString()
+	}
+	if vlanMin, ok := attrs["vlan_min"].(types.Int64); ok && !vlanMin.IsNull() {
+		v := int(vlanMin.ValueInt64())
+		f.VLANMin = &v
+	}
+	if vlanMax, ok := attrs["vlan_max"].(types.Int64); ok && !vlanMax.IsNull() {
+		v := int(vlanMax.ValueInt64())
+		f.VLANMax = &v
+	}
+	if f.VLANMin != nil && f.VLANMax != nil && *f.VLANMin > *f.VLANMax {
+		diags.AddError("Invalid VLAN range", fmt.Sprintf("vlan_min (%d) must not be greater than vlan_max (%d)", *f.VLANMin, *f.VLANMax))
+		return f, diags
+	}
+	if enabled, ok := attrs["enabled"].(types.Bool); ok && !enabled.IsNull() {
+		v := enabled.ValueBool()
+		f.Enabled = &v
+	}
+	return f, diags
+}
+
+func (f ListFilters) attributes() map[string]schema.Attribute {
+	attrs := map[string]schema.Attribute{
+		"name_regex": schema.StringAttribute{
+			MarkdownDescription: "Only return objects whose name matches this regular expression.",
+			Optional:            true,
+		},
+	}
+	if f.Purpose != "" {
+		attrs["purpose"] = schema.StringAttribute{
+			MarkdownDescription: f.Purpose,
+			Optional:            true,
+		}
+	}
+	if f.VLAN {
+		vlanValidators := []validator.Int64{int64validator.Between(0, 4095)}
+		attrs["vlan_min"] = schema.Int64Attribute{
+			MarkdownDescription: "Only return objects with a VLAN ID greater than or equal to this one. Untagged objects have VLAN ID `0`.",
+			Optional:            true,
+			Validators:          vlanValidators,
+		}
+		attrs["vlan_max"] = schema.Int64Attribute{
+			MarkdownDescription: "Only return objects with a VLAN ID less than or equal to this one. Untagged objects have VLAN ID `0`.",
+			Optional:            true,
+			Validators:          vlanValidators,
+		}
+	}
+	if f.Enabled {
+		attrs["enabled"] = schema.BoolAttribute{
+			MarkdownDescription: "Only return enabled (`true`) or disabled (`false`) objects.",
+			Optional:            true,
+		}
+	}
+	return attrs
+}
+
+// ListDatasourceFunctions are the handlers of a list data source listing
+// objects of type T into result items of type M.
+type ListDatasourceFunctions[T any, M any] struct {
+	List     func(ctx context.Context, client *Client, site string) ([]T, error)
+	Describe func(item T) ListItem
+	Convert  func(ctx context.Context, item T) (M, diag.Diagnostics)
+}
+
+type listDatasourceModel[M any] struct {
+	Site   ut.SiteValue `tfsdk:"site"`
+	Filter types.Object `tfsdk:"filter"`
+	Result []M          `tfsdk:"result"`
+}
+
+func (m *listDatasourceModel[M]) GetSite() string {
+	return m.Site.ValueString()
+}
+
+func (m *listDatasourceModel[M]) GetRawSite() types.String {
+	return m.Site.StringValue
+}
+
+func (m *listDatasourceModel[M]) SetSite(site string) {
+	m.Site = ut.NewSiteValue(site)
+}
+
+// GenericListDatasource provides common functionality for data sources
+// listing all objects of a kind, filtered by the common list filters.
+type GenericListDatasource[T any, M any] struct {
+	ControllerVersionValidator
+	FeatureValidator
+	client         *Client
+	typeName       string
+	description    string
+	itemAttributes map[string]schema.Attribute
+	filters        ListFilters
+	Handlers       ListDatasourceFunctions[T, M]
+}
+
+// NewGenericListDatasource creates a new list data source. itemAttributes
+// describe an item of the `result` list, whose model is M.
+func NewGenericListDatasource[T any, M any](
+	typeName string,
+	description string,
+	itemAttributes map[string]schema.Attribute,
+	filters ListFilters,
+	handlers ListDatasourceFunctions[T, M],
+) *GenericListDatasource[T, M] {
+	return &GenericListDatasource[T, M]{
+		typeName:       typeName,
+		description:    description,
+		itemAttributes: itemAttributes,
+		filters:        filters,
+		Handlers:       handlers,
+	}
+}
+
+// GetClient returns the UniFi client.
+func (d *GenericListDatasource[T, M]) GetClient() *Client {
+	return d.client
+}
+
+// SetClient sets the UniFi client.
+func (d *GenericListDatasource[T, M]) SetClient(client *Client) {
+	d.client = client
+}
+
+func (d *GenericListDatasource[T, M]) SetVersionValidator(validator ControllerVersionValidator) {
+	d.ControllerVersionValidator = validator
+}
+
+func (d *GenericListDatasource[T, M]) SetFeatureValidator(validator FeatureValidator) {
+	d.FeatureValidator = validator
+}
+
+func (d *GenericListDatasource[T, M]) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	ConfigureDatasource(d, req, resp)
+}
+
+func (d *GenericListDatasource[T, M]) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.typeName
+}
+
+func (d *GenericListDatasource[T, M]) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: d.description,
+		Attributes: map[string]schema.Attribute{
+			"site": ut.SiteAttribute(),
+			"filter": schema.SingleNestedAttribute{
+				MarkdownDescription: "Filters the returned objects. All filters set are combined, an object must match every one of them to be returned.",
+				Optional:            true,
+				Attributes:          d.filters.attributes(),
+			},
+			"result": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching objects.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: d.itemAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *GenericListDatasource[T, M]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(checkClientConfigured(d.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state listDatasourceModel[M]
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	site, diags := d.client.ResolveSite(&state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := ListFilterFromObject(state.Filter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := d.Handlers.List(ctx, d.client, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list objects", err.Error())
+		return
+	}
+
+	state.Result = []M{}
+	for _, item := range items {
+		if !filter.Matches(d.Handlers.Describe(item)) {
+			continue
+		}
+		m, diags := d.Handlers.Convert(ctx, item)
+		resp.Diagnostics.Append(diags...)
+		state.Result = append(state.Result, m)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.SetSite(site)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
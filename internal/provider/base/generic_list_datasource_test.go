@@ -0,0 +1,100 @@
+package base
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var listFilterAttrTypes = map[string]attr.Type{
+	"name_regex": types.StringType,
+	"purpose":    types.StringType,
+	"vlan_min":   types.Int64Type,
+	"vlan_max":   types.Int64Type,
+	"enabled":    types.BoolType,
+}
+
+func listFilterObject(t *testing.T, nameRegex, purpose string, vlanMin, vlanMax *int64, enabled *bool) types.Object {
+	t.Helper()
+	values := map[string]attr.Value{
+		"name_regex": types.StringNull(),
+		"purpose":    types.StringNull(),
+		"vlan_min":   types.Int64PointerValue(vlanMin),
+		"vlan_max":   types.Int64PointerValue(vlanMax),
+		"enabled":    types.BoolPointerValue(enabled),
+	}
+	if nameRegex != "" {
+		values["name_regex"] = types.StringValue(nameRegex)
+	}
+	if purpose != "" {
+		values["purpose"] = types.StringValue(purpose)
+	}
+	obj, diags := types.ObjectValue(listFilterAttrTypes, values)
+	require.False(t, diags.HasError(), diags)
+	return obj
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestListFilterFromObject(t *testing.T) {
+	f, diags := ListFilterFromObject(types.ObjectNull(listFilterAttrTypes))
+	require.False(t, diags.HasError())
+	assert.True(t, f.Matches(ListItem{Name: "anything"}))
+
+	f, diags = ListFilterFromObject(listFilterObject(t, "^iot-", "corporate", ptr(int64(10)), ptr(int64(20)), ptr(true)))
+	require.False(t, diags.HasError())
+	assert.True(t, f.Matches(ListItem{Name: "iot-cams", Purpose: "corporate", VLAN: 10, Enabled: true}))
+	assert.True(t, f.Matches(ListItem{Name: "iot-cams", Purpose: "corporate", VLAN: 20, Enabled: true}))
+	assert.False(t, f.Matches(ListItem{Name: "lan", Purpose: "corporate", VLAN: 10, Enabled: true}))
+	assert.False(t, f.Matches(ListItem{Name: "iot-cams", Purpose: "guest", VLAN: 10, Enabled: true}))
+	assert.False(t, f.Matches(ListItem{Name: "iot-cams", Purpose: "corporate", VLAN: 9, Enabled: true}))
+	assert.False(t, f.Matches(ListItem{Name: "iot-cams", Purpose: "corporate", VLAN: 21, Enabled: true}))
+	assert.False(t, f.Matches(ListItem{Name: "iot-cams", Purpose: "corporate", VLAN: 10, Enabled: false}))
+
+	f, diags = ListFilterFromObject(listFilterObject(t, "", "", nil, ptr(int64(0)), nil))
+	require.False(t, diags.HasError())
+	assert.True(t, f.Matches(ListItem{Name: "untagged"}))
+	assert.False(t, f.Matches(ListItem{Name: "tagged", VLAN: 5}))
+}
+
+func TestListFilterFromObject_Invalid(t *testing.T) {
+	_, diags := ListFilterFromObject(listFilterObject(t, "(", "", nil, nil, nil))
+	assert.True(t, diags.HasError())
+
+	_, diags = ListFilterFromObject(listFilterObject(t, "", "", ptr(int64(20)), ptr(int64(10)), nil))
+	assert.True(t, diags.HasError())
+}
+
+func TestGenericListDatasource_SchemaFilters(t *testing.T) {
+	handlers := ListDatasourceFunctions[string, string]{
+		Describe: func(item string) ListItem { return ListItem{Name: item} },
+		Convert: func(_ context.Context, item string) (string, diag.Diagnostics) {
+			return item, nil
+		},
+	}
+	filterAttributes := func(filters ListFilters) []string {
+		d := NewGenericListDatasource("unifi_things", "Things.", map[string]schema.Attribute{}, filters, handlers)
+		resp := &datasource.SchemaResponse{}
+		d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+		filter, ok := resp.Schema.Attributes["filter"].(schema.SingleNestedAttribute)
+		require.True(t, ok)
+		var names []string
+		for name := range filter.Attributes {
+			names = append(names, name)
+		}
+		return names
+	}
+
+	assert.ElementsMatch(t, []string{"name_regex"}, filterAttributes(ListFilters{}))
+	assert.ElementsMatch(t, []string{"name_regex", "purpose", "vlan_min", "vlan_max", "enabled"},
+		filterAttributes(ListFilters{Purpose: "Only return things of this purpose.", VLAN: true, Enabled: true}))
+}
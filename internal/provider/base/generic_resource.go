@@ -8,6 +8,7 @@
 	"github.com/filipowm/go-unifi/unifi"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
 type ResourceFunctions struct {
@@ -79,7 +80,7 @@ func (b *GenericResource[T]) ImportState(ctx context.Context, req resource.Impor
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	id, site := ImportIDWithSite(req, resp)
+	id, site := ImportIDWithSite(b.client, req, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -109,7 +110,15 @@ func (b *GenericResource[T]) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	site := b.client.ResolveSite(plan)
+	resp.Diagnostics.Append(loadWriteOnly(ctx, plan, req.Config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	site, diags := b.client.ResolveSite(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	body, diags := plan.AsUnifiModel(ctx)
 
@@ -145,6 +154,27 @@ func (b *GenericResource[T]) Create(ctx context.Context, req resource.CreateRequ
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// loadWriteOnly loads the write-only attributes of a planned model from the
+// configuration, if it has any.
+func loadWriteOnly(ctx context.Context, model interface{}, config tfsdk.Config) diag.Diagnostics {
+	if m, ok := model.(WriteOnlyModel); ok {
+		return m.LoadWriteOnly(ctx, config)
+	}
+	return nil
+}
+
+// keepWriteOnlyVersion copies the planned version of write-only attributes to
+// the updated state, if the model has one.
+func keepWriteOnlyVersion(plan, state interface{}) {
+	p, ok := plan.(WriteOnlyVersionModel)
+	if !ok {
+		return
+	}
+	if s, ok := state.(WriteOnlyVersionModel); ok {
+		s.SetWriteOnlyVersion(p.GetWriteOnlyVersion())
+	}
+}
+
 func (b *GenericResource[T]) read(ctx context.Context, site string, state T, diag *diag.Diagnostics) {
 	res, err := b.Handlers.Read(ctx, b.client, site, state.GetID())
 	if err != nil {
@@ -176,7 +206,11 @@ func (b *GenericResource[T]) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	site := b.client.ResolveSite(state)
+	site, diags := b.client.ResolveSite(state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	b.read(ctx, site, state, &resp.Diagnostics)
 
 	if resp.Diagnostics.HasError() {
@@ -202,12 +236,20 @@ func (b *GenericResource[T]) Update(ctx context.Context, req resource.UpdateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(loadWriteOnly(ctx, plan, req.Config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	body, diags := plan.AsUnifiModel(ctx)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	site := b.client.ResolveSite(plan)
+	site, diags := b.client.ResolveSite(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	res, err := b.Handlers.Update(ctx, b.client, site, body)
 	if err != nil {
@@ -216,6 +258,7 @@ func (b *GenericResource[T]) Update(ctx context.Context, req resource.UpdateRequ
 	}
 	state.Merge(ctx, res)
 	state.SetSite(site)
+	keepWriteOnlyVersion(plan, state)
 
 	// Opt-in read-after-write: re-read from the persisted datastore so the
 	// final state reflects the GET response rather than the (possibly
@@ -245,7 +288,11 @@ func (b *GenericResource[T]) Delete(ctx context.Context, req resource.DeleteRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	site := b.client.ResolveSite(state)
+	site, diags := b.client.ResolveSite(state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	err := b.Handlers.Delete(ctx, b.client, site, state.GetID())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting resource", err.Error())
@@ -6,22 +6,37 @@
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
 )
 
 // for Terraform Plugin SDK v2.
-func ImportSiteAndID(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func ImportSiteAndID(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	if id := d.Id(); strings.Contains(id, ":") {
 		importParts := strings.SplitN(id, ":", 2)
+		site, err := resolveImportSite(meta, importParts[0])
+		if err != nil {
+			return nil, err
+		}
 		d.SetId(importParts[1])
-		if err := d.Set("site", importParts[0]); err != nil {
+		if err := d.Set("site", site); err != nil {
 			return nil, err
 		}
 	}
 	return []*schema.ResourceData{d}, nil
 }
 
+// resolveImportSite resolves the site of an import ID against the controller
+// of the client, when it is configured.
+func resolveImportSite(meta interface{}, site string) (string, error) {
+	if c, ok := meta.(*Client); ok && c != nil {
+		return c.ResolveSiteName(site)
+	}
+	return utils.ResolveSiteName(site)
+}
+
 // for Terraform Plugin Framework.
-func ImportIDWithSite(req resource.ImportStateRequest, resp *resource.ImportStateResponse) (string, string) {
+func ImportIDWithSite(client *Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse) (string, string) {
 	id := req.ID
 	if id == "" {
 		resp.Diagnostics.AddError("Invalid ID", "ID is required")
@@ -31,7 +46,11 @@ func ImportIDWithSite(req resource.ImportStateRequest, resp *resource.ImportStat
 	if strings.Contains(id, ":") {
 		importParts := strings.SplitN(id, ":", 2)
 		if len(importParts) == 2 {
-			return importParts[1], importParts[0]
+			site, err := resolveImportSite(client, importParts[0])
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid site", err.Error())
+			}
+			return importParts[1], site
 		}
 		resp.Diagnostics.AddError("Invalid ID", "ID contains too many colon-separated parts. Format should be 'site:id'")
 		return "", ""
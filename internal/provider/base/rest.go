@@ -0,0 +1,94 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/filipowm/go-unifi/unifi"
+)
+
+// RestResponse is the envelope the controller wraps every legacy (non-v2) API
+// response in.
+type RestResponse[T any] struct {
+	Meta struct {
+		RC  string `json:"rc"`
+		Msg string `json:"msg,omitempty"`
+	} `json:"meta"`
+	Data []T `json:"data"`
+}
+
+// RestPath returns the site-scoped path of a legacy REST collection, optionally
+// followed by an object ID.
+func RestPath(site, collection string, id ...string) string {
+	p := fmt.Sprintf("s/%s/rest/%s", site, collection)
+	if len(id) > 0 && id[0] != "" {
+		p += "/" + id[0]
+	}
+	return p
+}
+
+// V2Path returns the site-scoped path of a v2 API collection, optionally
+// followed by an object ID. go-unifi resolves paths without a leading slash
+// against the legacy API path, i.e. `/api`, or `/proxy/network/api` on UniFi
+// OS, so the v2 API served next to it is addressed relative to it.
+func V2Path(site, collection string, id ...string) string {
+	p := fmt.Sprintf("../v2/api/site/%s/%s", site, collection)
+	if len(id) > 0 && id[0] != "" {
+		p += "/" + id[0]
+	}
+	return p
+}
+
+// ListRest lists all objects of a site-scoped REST collection. It is used for
+// controller objects go-unifi does not model.
+func ListRest[T any](ctx context.Context, c unifi.Client, site, collection string) ([]T, error) {
+	var resp RestResponse[T]
+	if err := c.Do(ctx, http.MethodGet, RestPath(site, collection), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// GetRest reads a single object of a site-scoped REST collection, returning
+// unifi.ErrNotFound when the controller does not know the ID.
+func GetRest[T any](ctx context.Context, c unifi.Client, site, collection, id string) (*T, error) {
+	return doRest[T](ctx, c, http.MethodGet, RestPath(site, collection, id), nil)
+}
+
+// CreateRest creates an object in a site-scoped REST collection.
+func CreateRest[T any](ctx context.Context, c unifi.Client, site, collection string, body *T) (*T, error) {
+	return doRest[T](ctx, c, http.MethodPost, RestPath(site, collection), body)
+}
+
+// UpdateRest replaces an object in a site-scoped REST collection.
+func UpdateRest[T any](ctx context.Context, c unifi.Client, site, collection, id string, body *T) (*T, error) {
+	return doRest[T](ctx, c, http.MethodPut, RestPath(site, collection, id), body)
+}
+
+// DeleteRest deletes an object from a site-scoped REST collection.
+func DeleteRest(ctx context.Context, c unifi.Client, site, collection, id string) error {
+	return c.Do(ctx, http.MethodDelete, RestPath(site, collection, id), struct{}{}, nil)
+}
+
+// GetSetting reads the setting of a site with the given key, e.g. `super_smtp`.
+// It is used for settings go-unifi does not model.
+func GetSetting[T any](ctx context.Context, c unifi.Client, site, key string) (*T, error) {
+	return doRest[T](ctx, c, http.MethodGet, fmt.Sprintf("s/%s/get/setting/%s", site, key), nil)
+}
+
+// UpdateSetting writes the setting of a site with the given key.
+func UpdateSetting[T any](ctx context.Context, c unifi.Client, site, key string, body *T) (*T, error) {
+	return doRest[T](ctx, c, http.MethodPut, fmt.Sprintf("s/%s/set/setting/%s", site, key), body)
+}
+
+func doRest[T any](ctx context.Context, c unifi.Client, method, apiPath string, body interface{}) (*T, error) {
+	var resp RestResponse[T]
+	if err := c.Do(ctx, method, apiPath, body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Data) != 1 {
+		return nil, unifi.ErrNotFound
+	}
+	return &resp.Data[0], nil
+}
@@ -0,0 +1,88 @@
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRestClient answers every Do call with a canned JSON body and records the
+// request it received.
+type fakeRestClient struct {
+	unifi.Client
+
+	response string
+	method   string
+	path     string
+}
+
+func (f *fakeRestClient) Do(_ context.Context, method, apiPath string, _ interface{}, respBody interface{}) error {
+	f.method = method
+	f.path = apiPath
+	if respBody == nil {
+		return nil
+	}
+	return json.Unmarshal([]byte(f.response), respBody)
+}
+
+type restTestObject struct {
+	ID   string `json:"_id"`
+	Name string `json:"name"`
+}
+
+func TestRestPath(t *testing.T) {
+	assert.Equal(t, "s/default/rest/hotspot2conf", RestPath("default", "hotspot2conf"))
+	assert.Equal(t, "s/default/rest/hotspot2conf/abc", RestPath("default", "hotspot2conf", "abc"))
+	assert.Equal(t, "s/default/rest/hotspot2conf", RestPath("default", "hotspot2conf", ""))
+}
+
+func TestV2Path(t *testing.T) {
+	assert.Equal(t, "../v2/api/site/default/trafficrules", V2Path("default", "trafficrules"))
+	assert.Equal(t, "../v2/api/site/default/trafficrules/abc", V2Path("default", "trafficrules", "abc"))
+}
+
+func TestGetRest(t *testing.T) {
+	c := &fakeRestClient{response: `{"meta":{"rc":"ok"},"data":[{"_id":"abc","name":"test"}]}`}
+	obj, err := GetRest[restTestObject](context.Background(), c, "default", "hotspot2conf", "abc")
+	require.NoError(t, err)
+	assert.Equal(t, &restTestObject{ID: "abc", Name: "test"}, obj)
+	assert.Equal(t, http.MethodGet, c.method)
+	assert.Equal(t, "s/default/rest/hotspot2conf/abc", c.path)
+}
+
+func TestGetRest_notFound(t *testing.T) {
+	c := &fakeRestClient{response: `{"meta":{"rc":"ok"},"data":[]}`}
+	_, err := GetRest[restTestObject](context.Background(), c, "default", "hotspot2conf", "abc")
+	assert.ErrorIs(t, err, unifi.ErrNotFound)
+}
+
+func TestListRest(t *testing.T) {
+	c := &fakeRestClient{response: `{"meta":{"rc":"ok"},"data":[{"_id":"a"},{"_id":"b"}]}`}
+	objs, err := ListRest[restTestObject](context.Background(), c, "site1", "hotspot2conf")
+	require.NoError(t, err)
+	assert.Len(t, objs, 2)
+	assert.Equal(t, "s/site1/rest/hotspot2conf", c.path)
+}
+
+func TestGetSetting(t *testing.T) {
+	c := &fakeRestClient{response: `{"meta":{"rc":"ok"},"data":[{"_id":"abc","name":"test"}]}`}
+	obj, err := GetSetting[restTestObject](context.Background(), c, "default", "super_identity")
+	require.NoError(t, err)
+	assert.Equal(t, &restTestObject{ID: "abc", Name: "test"}, obj)
+	assert.Equal(t, http.MethodGet, c.method)
+	assert.Equal(t, "s/default/get/setting/super_identity", c.path)
+}
+
+func TestUpdateSetting(t *testing.T) {
+	c := &fakeRestClient{response: `{"meta":{"rc":"ok"},"data":[{"_id":"abc","name":"test"}]}`}
+	obj, err := UpdateSetting(context.Background(), c, "default", "super_identity", &restTestObject{Name: "test"})
+	require.NoError(t, err)
+	assert.Equal(t, "abc", obj.ID)
+	assert.Equal(t, http.MethodPut, c.method)
+	assert.Equal(t, "s/default/set/setting/super_identity", c.path)
+}
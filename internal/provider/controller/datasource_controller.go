@@ -0,0 +1,215 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/filipowm/go-unifi/unifi/features"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+// sysInfo is the system information of the controller, as returned for a
+// site. Only UniFi OS consoles report their device type.
+type sysInfo struct {
+	Hostname       string `json:"hostname"`
+	Version        string `json:"version"`
+	Build          string `json:"build"`
+	UbntDeviceType string `json:"ubnt_device_type"`
+	UDMVersion     string `json:"udm_version"`
+}
+
+func (s sysInfo) isUnifiOS() bool {
+	return s.UbntDeviceType != "" || s.UDMVersion != ""
+}
+
+func getSysInfo(ctx context.Context, c *base.Client, site string) (*sysInfo, error) {
+	var resp base.RestResponse[sysInfo]
+	if err := c.Do(ctx, http.MethodGet, fmt.Sprintf("s/%s/stat/sysinfo", site), nil, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Data) == 0 {
+		return &sysInfo{}, nil
+	}
+	return &resp.Data[0], nil
+}
+
+type capabilitiesModel struct {
+	ZoneBasedFirewall    types.Bool `tfsdk:"zone_based_firewall"`
+	IPS                  types.Bool `tfsdk:"ips"`
+	DNSRecords           types.Bool `tfsdk:"dns_records"`
+	WPA3                 types.Bool `tfsdk:"wpa3"`
+	APIKeyAuthentication types.Bool `tfsdk:"api_key_authentication"`
+}
+
+// capabilitiesFrom derives what the provider can manage on the site from the
+// controller version and the features of the site.
+func capabilitiesFrom(c *base.Client, f base.Features) *capabilitiesModel {
+	return &capabilitiesModel{
+		// Zone-based firewall resources require both features, see firewall.
+		ZoneBasedFirewall:    types.BoolValue(f.IsEnabled(features.ZoneBasedFirewall) && f.IsEnabled(features.ZoneBasedFirewallMigration)),
+		IPS:                  types.BoolValue(f.IsEnabled(features.Ips)),
+		DNSRecords:           types.BoolValue(c.SupportsDNSRecords()),
+		WPA3:                 types.BoolValue(c.SupportsWPA3()),
+		APIKeyAuthentication: types.BoolValue(c.SupportsAPIKeyAuthentication()),
+	}
+}
+
+type controllerDatasourceModel struct {
+	Site              ut.SiteValue       `tfsdk:"site"`
+	Version           types.String       `tfsdk:"version"`
+	Build             types.String       `tfsdk:"build"`
+	Hostname          types.String       `tfsdk:"hostname"`
+	UnifiOS           types.Bool         `tfsdk:"unifi_os"`
+	EnabledFeatures   types.Set          `tfsdk:"enabled_features"`
+	AvailableFeatures types.Set          `tfsdk:"available_features"`
+	Capabilities      *capabilitiesModel `tfsdk:"capabilities"`
+}
+
+func (m *controllerDatasourceModel) GetSite() string {
+	return m.Site.ValueString()
+}
+
+func (m *controllerDatasourceModel) GetRawSite() types.String {
+	return m.Site.StringValue
+}
+
+func (m *controllerDatasourceModel) SetSite(site string) {
+	m.Site = ut.NewSiteValue(site)
+}
+
+var (
+	_ datasource.DataSource              = &controllerDatasource{}
+	_ datasource.DataSourceWithConfigure = &controllerDatasource{}
+	_ base.Resource                      = &controllerDatasource{}
+)
+
+type controllerDatasource struct {
+	base.ControllerVersionValidator
+	base.FeatureValidator
+	client *base.Client
+}
+
+func NewControllerDatasource() datasource.DataSource {
+	return &controllerDatasource{}
+}
+
+func (d *controllerDatasource) SetClient(client *base.Client) {
+	d.client = client
+}
+
+func (d *controllerDatasource) SetVersionValidator(validator base.ControllerVersionValidator) {
+	d.ControllerVersionValidator = validator
+}
+
+func (d *controllerDatasource) SetFeatureValidator(validator base.FeatureValidator) {
+	d.FeatureValidator = validator
+}
+
+func (d *controllerDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	base.ConfigureDatasource(d, req, resp)
+}
+
+func (d *controllerDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_controller"
+}
+
+func (d *controllerDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	capability := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		}
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_controller` data source returns information about the controller the provider is connected to " +
+			"and the features of a site. Use it to make parts of a configuration conditional on the controller, e.g. with " +
+			"`count`, instead of failing on controllers lacking a feature.",
+		Attributes: map[string]schema.Attribute{
+			"site": ut.SiteAttribute("The name of the UniFi site whose features should be returned, either the internal site name or its description. " +
+				"If not specified, the default site will be used."),
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The version of the UniFi Network application, e.g. `9.0.114`.",
+				Computed:            true,
+			},
+			"build": schema.StringAttribute{
+				MarkdownDescription: "The build of the UniFi Network application.",
+				Computed:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname of the controller.",
+				Computed:            true,
+			},
+			"unifi_os": schema.BoolAttribute{
+				MarkdownDescription: "Whether the controller runs on a UniFi OS console, such as a UDM or Cloud Key Gen2, rather than as a standalone application.",
+				Computed:            true,
+			},
+			"enabled_features": schema.SetAttribute{
+				MarkdownDescription: "The names of the features enabled on the site, e.g. `ZONE_BASED_FIREWALL`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"available_features": schema.SetAttribute{
+				MarkdownDescription: "The names of all features the controller knows for the site, enabled or not.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"capabilities": schema.SingleNestedAttribute{
+				MarkdownDescription: "What the provider can manage on the site.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"zone_based_firewall":    capability("Whether the site uses the zone-based firewall, managed with `unifi_firewall_zone` and `unifi_firewall_zone_policy`."),
+					"ips":                    capability("Whether the intrusion prevention system can be managed with `unifi_setting_ips`."),
+					"dns_records":            capability("Whether DNS records can be managed with `unifi_dns_record`."),
+					"wpa3":                   capability("Whether WLANs can use WPA3."),
+					"api_key_authentication": capability("Whether the provider can authenticate with an API key."),
+				},
+			},
+		},
+	}
+}
+
+func (d *controllerDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state controllerDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	site, diags := d.client.ResolveSite(&state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := getSysInfo(ctx, d.client, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read controller information", err.Error())
+		return
+	}
+	list, err := d.client.ListFeatures(ctx, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list controller features", err.Error())
+		return
+	}
+	f := base.NewFeatures(list)
+
+	state.Version = types.StringValue(d.client.Version.String())
+	state.Build = types.StringValue(info.Build)
+	state.Hostname = types.StringValue(info.Hostname)
+	state.UnifiOS = types.BoolValue(info.isUnifiOS())
+	state.EnabledFeatures, diags = types.SetValueFrom(ctx, types.StringType, f.Names(true))
+	resp.Diagnostics.Append(diags...)
+	state.AvailableFeatures, diags = types.SetValueFrom(ctx, types.StringType, f.Names(false))
+	resp.Diagnostics.Append(diags...)
+	state.Capabilities = capabilitiesFrom(d.client, f)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.SetSite(site)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
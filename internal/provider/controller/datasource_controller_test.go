@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/filipowm/go-unifi/unifi/features"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+)
+
+func TestSysInfo_IsUnifiOS(t *testing.T) {
+	var console, standalone sysInfo
+	require.NoError(t, json.Unmarshal([]byte(`{"hostname": "udm", "version": "9.0.114", "build": "atag_9.0.114_29023", "ubnt_device_type": "UDMPRO", "udm_version": "4.1.13"}`), &console))
+	require.NoError(t, json.Unmarshal([]byte(`{"hostname": "unifi", "version": "8.6.9", "build": "atag_8.6.9_27390"}`), &standalone))
+
+	assert.True(t, console.isUnifiOS())
+	assert.Equal(t, "atag_9.0.114_29023", console.Build)
+	assert.False(t, standalone.isUnifiOS())
+}
+
+func TestCapabilitiesFrom(t *testing.T) {
+	client := &base.Client{Version: base.AsVersion("9.0.114")}
+	f := base.NewFeatures([]unifi.DescribedFeature{
+		{Name: features.ZoneBasedFirewall, FeatureExists: true},
+		{Name: features.ZoneBasedFirewallMigration, FeatureExists: true},
+		{Name: features.Ips, FeatureExists: false},
+	})
+
+	c := capabilitiesFrom(client, f)
+	assert.True(t, c.ZoneBasedFirewall.ValueBool())
+	assert.False(t, c.IPS.ValueBool())
+	assert.True(t, c.DNSRecords.ValueBool())
+	assert.True(t, c.WPA3.ValueBool())
+	assert.True(t, c.APIKeyAuthentication.ValueBool())
+
+	c = capabilitiesFrom(&base.Client{Version: base.AsVersion("8.0.26")}, base.Features{})
+	assert.False(t, c.ZoneBasedFirewall.ValueBool())
+	assert.False(t, c.DNSRecords.ValueBool())
+	assert.False(t, c.APIKeyAuthentication.ValueBool())
+}
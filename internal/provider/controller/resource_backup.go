@@ -0,0 +1,248 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+)
+
+// backupSite is the site the backup command is sent to. A backup covers the
+// whole controller, all sites included, whatever site it is requested on, so
+// it is always requested on the default site, which every controller has,
+// rather than on the site of the provider configuration.
+const backupSite = "default"
+
+// createBackup makes the controller take a backup including the given number
+// of days of history, returning the path the backup is served at.
+func createBackup(ctx context.Context, c *base.Client, days int) (string, error) {
+	var resp base.RestResponse[struct {
+		URL string `json:"url"`
+	}]
+	cmd := map[string]interface{}{
+		"cmd":  "backup",
+		"days": days,
+	}
+	if err := c.Do(ctx, http.MethodPost, fmt.Sprintf("s/%s/cmd/backup", backupSite), cmd, &resp); err != nil {
+		return "", err
+	}
+	if len(resp.Data) == 0 || resp.Data[0].URL == "" {
+		return "", errors.New("the controller did not return the location of the backup")
+	}
+	return resp.Data[0].URL, nil
+}
+
+// writeBackup downloads a backup to the given file, returning its size and
+// SHA-256 checksum. The backup is written to a temporary file first, so an
+// existing file is only replaced by a complete backup.
+func writeBackup(ctx context.Context, c *base.Client, url, file string) (int64, string, error) {
+	dir := filepath.Dir(file)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return 0, "", err
+	}
+	tmp, err := os.CreateTemp(dir, ".unifi-backup-*")
+	if err != nil {
+		return 0, "", err
+	}
+	// Once renamed, the temporary file no longer exists.
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	hash := sha256.New()
+	counter := &countingWriter{}
+	err = c.Download(ctx, url, io.MultiWriter(tmp, hash, counter))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, "", err
+	}
+	if err := os.Rename(tmp.Name(), file); err != nil {
+		return 0, "", err
+	}
+	return counter.n, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+type backupModel struct {
+	ID       types.String `tfsdk:"id"`
+	Path     types.String `tfsdk:"path"`
+	Days     types.Int32  `tfsdk:"days"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	Filename types.String `tfsdk:"filename"`
+	Size     types.Int64  `tfsdk:"size"`
+	SHA256   types.String `tfsdk:"sha256"`
+}
+
+var (
+	_ resource.Resource              = &backupResource{}
+	_ resource.ResourceWithConfigure = &backupResource{}
+	_ base.Resource                  = &backupResource{}
+)
+
+// backupResource takes a backup of the controller when it is created. Every
+// attribute requires replacement, so a new backup is only taken when the
+// configuration, e.g. its `triggers`, changes; reading it has no side effects.
+type backupResource struct {
+	base.ControllerVersionValidator
+	base.FeatureValidator
+	client *base.Client
+}
+
+func NewBackupResource() resource.Resource {
+	return &backupResource{}
+}
+
+func (r *backupResource) SetClient(client *base.Client) {
+	r.client = client
+}
+
+func (r *backupResource) SetVersionValidator(validator base.ControllerVersionValidator) {
+	r.ControllerVersionValidator = validator
+}
+
+func (r *backupResource) SetFeatureValidator(validator base.FeatureValidator) {
+	r.FeatureValidator = validator
+}
+
+func (r *backupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	base.ConfigureResource(r, req, resp)
+}
+
+func (r *backupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup"
+}
+
+func (r *backupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_backup` resource takes a backup of the UniFi controller and writes the `.unf` file to a local path.\n\n" +
+			"The backup is taken when the resource is created, and again whenever it is replaced, i.e. when `path`, `days` or " +
+			"`triggers` change. Set `triggers` to values that change when a new backup is wanted, e.g. `timestamp()` to snapshot the " +
+			"controller on every `terraform apply`. Plans and refreshes never take a backup. Destroying the resource keeps the file.\n\n" +
+			"A backup covers the whole controller, with all of its sites, whatever `site` the provider is configured with. " +
+			"Use `unifi_setting_autobackup` to schedule backups on the controller instead.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the backup, its SHA-256 checksum.",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The local path to write the backup to. Missing directories are created and an existing file is replaced.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"days": schema.Int32Attribute{
+				MarkdownDescription: "The number of days of history, like statistics and events, to include in the backup. Defaults to `0`, backing up settings only.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that take a new backup when changed.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"filename": schema.StringAttribute{
+				MarkdownDescription: "The name of the backup on the controller, e.g. `9.0.114.unf`.",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "The size of the backup in bytes.",
+				Computed:            true,
+			},
+			"sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 checksum of the backup, hex-encoded.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *backupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan backupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url, err := createBackup(ctx, r.client, int(plan.Days.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create backup", err.Error())
+		return
+	}
+	size, checksum, err := writeBackup(ctx, r.client, url, plan.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to write backup", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(checksum)
+	plan.Filename = types.StringValue(path.Base(url))
+	plan.Size = types.Int64Value(size)
+	plan.SHA256 = types.StringValue(checksum)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as is: the backup is a snapshot taken at creation, and
+// the controller may already have pruned it.
+func (r *backupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state backupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with changes, as every configurable attribute
+// requires replacement.
+func (r *backupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan backupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the backup from state, keeping the written file.
+func (r *backupResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
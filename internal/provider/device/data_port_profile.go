@@ -48,9 +48,9 @@ func dataPortProfileRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	name, _ := d.Get("name").(string)
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	groups, err := c.ListPortProfile(ctx, site)
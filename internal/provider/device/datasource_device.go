@@ -0,0 +1,298 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+type devicePortDatasourceModel struct {
+	Number     types.Int64  `tfsdk:"number"`
+	Name       types.String `tfsdk:"name"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Up         types.Bool   `tfsdk:"up"`
+	Speed      types.Int64  `tfsdk:"speed"`
+	FullDuplex types.Bool   `tfsdk:"full_duplex"`
+	IsUplink   types.Bool   `tfsdk:"is_uplink"`
+	PoE        types.Bool   `tfsdk:"poe"`
+	Media      types.String `tfsdk:"media"`
+}
+
+type deviceDatasourceModel struct {
+	base.Model
+	MAC         types.String                `tfsdk:"mac"`
+	Name        types.String                `tfsdk:"name"`
+	DeviceModel types.String                `tfsdk:"model"`
+	Type        types.String                `tfsdk:"type"`
+	State       types.String                `tfsdk:"state"`
+	Adopted     types.Bool                  `tfsdk:"adopted"`
+	Disabled    types.Bool                  `tfsdk:"disabled"`
+	IP          types.String                `tfsdk:"ip"`
+	Version     types.String                `tfsdk:"version"`
+	UplinkMAC   types.String                `tfsdk:"uplink_mac"`
+	UplinkPort  types.Int64                 `tfsdk:"uplink_port"`
+	UplinkType  types.String                `tfsdk:"uplink_type"`
+	PortTable   []devicePortDatasourceModel `tfsdk:"port_table"`
+}
+
+// Merge updates the model with a device read from the controller.
+func (m *deviceDatasourceModel) Merge(_ context.Context, other interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	device, ok := other.(*deviceStat)
+	if !ok {
+		diags.AddError("Invalid model type", "Expected *deviceStat")
+		return diags
+	}
+
+	m.ID = types.StringValue(device.ID)
+	m.MAC = types.StringValue(device.MAC)
+	m.Name = types.StringValue(device.Name)
+	m.DeviceModel = types.StringValue(device.Model)
+	m.Type = types.StringValue(device.Type)
+	m.State = types.StringValue(device.State.String())
+	m.Adopted = types.BoolValue(device.Adopted)
+	m.Disabled = types.BoolValue(device.Disabled)
+	m.IP = types.StringValue(device.IP)
+	m.Version = types.StringValue(device.Version)
+	m.UplinkMAC = types.StringValue(device.Uplink.UplinkMAC)
+	m.UplinkPort = types.Int64Value(int64(device.Uplink.UplinkRemotePort))
+	m.UplinkType = types.StringValue(device.Uplink.Type)
+	m.PortTable = make([]devicePortDatasourceModel, 0, len(device.PortTable))
+	for _, port := range device.PortTable {
+		m.PortTable = append(m.PortTable, devicePortDatasourceModel{
+			Number:     types.Int64Value(int64(port.PortIDX)),
+			Name:       types.StringValue(port.Name),
+			Enabled:    types.BoolValue(port.Enable),
+			Up:         types.BoolValue(port.Up),
+			Speed:      types.Int64Value(int64(port.Speed)),
+			FullDuplex: types.BoolValue(port.FullDuplex),
+			IsUplink:   types.BoolValue(port.IsUplink),
+			PoE:        types.BoolValue(port.PoeEnable),
+			Media:      types.StringValue(port.Media),
+		})
+	}
+
+	return diags
+}
+
+// deviceDatasourceAttributes returns the attributes describing a device. The
+// lookup attributes `mac` and `name` are optional so the single-device data
+// source can search by them; the list data source only reads them.
+func deviceDatasourceAttributes(lookup bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id":   ut.ID("The ID of the device."),
+		"site": ut.SiteAttribute(),
+		"mac": schema.StringAttribute{
+			MarkdownDescription: "The MAC address of the device.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the device.",
+			Optional:            lookup,
+			Computed:            true,
+		},
+		"model": schema.StringAttribute{
+			MarkdownDescription: "The model code of the device, e.g. `US24P250`. See the `unifi_device_model` data source.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "The device type, e.g. `usw` (switch), `uap` (access point), `ugw` or `udm` (gateway).",
+			Computed:            true,
+		},
+		"state": schema.StringAttribute{
+			MarkdownDescription: "The state of the device, e.g. `Connected`, `Pending` or `Disconnected`.",
+			Computed:            true,
+		},
+		"adopted": schema.BoolAttribute{
+			MarkdownDescription: "Whether the device is adopted by the controller.",
+			Computed:            true,
+		},
+		"disabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the device is disabled.",
+			Computed:            true,
+		},
+		"ip": schema.StringAttribute{
+			MarkdownDescription: "The IP address of the device.",
+			Computed:            true,
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "The firmware version of the device.",
+			Computed:            true,
+		},
+		"uplink_mac": schema.StringAttribute{
+			MarkdownDescription: "The MAC address of the device this device is uplinked to. Empty for gateways.",
+			Computed:            true,
+		},
+		"uplink_port": schema.Int64Attribute{
+			MarkdownDescription: "The port of the uplink device this device is connected to.",
+			Computed:            true,
+		},
+		"uplink_type": schema.StringAttribute{
+			MarkdownDescription: "The uplink type, `wire` or `wireless`.",
+			Computed:            true,
+		},
+		"port_table": schema.ListNestedAttribute{
+			MarkdownDescription: "The ports of the device.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"number": schema.Int64Attribute{
+						MarkdownDescription: "The port number.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The port name.",
+						Computed:            true,
+					},
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the port is enabled.",
+						Computed:            true,
+					},
+					"up": schema.BoolAttribute{
+						MarkdownDescription: "Whether the port has a link.",
+						Computed:            true,
+					},
+					"speed": schema.Int64Attribute{
+						MarkdownDescription: "The link speed in Mbps.",
+						Computed:            true,
+					},
+					"full_duplex": schema.BoolAttribute{
+						MarkdownDescription: "Whether the link is full duplex.",
+						Computed:            true,
+					},
+					"is_uplink": schema.BoolAttribute{
+						MarkdownDescription: "Whether the port is the device's uplink.",
+						Computed:            true,
+					},
+					"poe": schema.BoolAttribute{
+						MarkdownDescription: "Whether PoE is enabled on the port.",
+						Computed:            true,
+					},
+					"media": schema.StringAttribute{
+						MarkdownDescription: "The port media, e.g. `GE` or `SFP+`.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+var (
+	_ datasource.DataSource                     = &deviceDatasource{}
+	_ datasource.DataSourceWithConfigure        = &deviceDatasource{}
+	_ datasource.DataSourceWithConfigValidators = &deviceDatasource{}
+	_ base.Resource                             = &deviceDatasource{}
+)
+
+type deviceDatasource struct {
+	base.ControllerVersionValidator
+	base.FeatureValidator
+	client *base.Client
+}
+
+func NewDeviceDatasource() datasource.DataSource {
+	return &deviceDatasource{}
+}
+
+func (d *deviceDatasource) SetClient(client *base.Client) {
+	d.client = client
+}
+
+func (d *deviceDatasource) SetVersionValidator(validator base.ControllerVersionValidator) {
+	d.ControllerVersionValidator = validator
+}
+
+func (d *deviceDatasource) SetFeatureValidator(validator base.FeatureValidator) {
+	d.FeatureValidator = validator
+}
+
+func (d *deviceDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	base.ConfigureDatasource(d, req, resp)
+}
+
+func (d *deviceDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("mac"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *deviceDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+func (d *deviceDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_device` data source looks up a single UniFi device (switch, access point or gateway) by " +
+			"MAC address or name, exposing its model, state, IP address, firmware version, uplink and port table.",
+		Attributes: deviceDatasourceAttributes(true),
+	}
+}
+
+func (d *deviceDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state deviceDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	site, diags := d.client.ResolveSite(&state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found *deviceStat
+	if mac := state.MAC.ValueString(); mac != "" {
+		device, err := getDeviceStatByMAC(ctx, d.client, site, mac)
+		if errors.Is(err, unifi.ErrNotFound) {
+			resp.Diagnostics.AddError("Device not found", fmt.Sprintf("No device with MAC address %q found in site %q", mac, site))
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read device", err.Error())
+			return
+		}
+		found = device
+	} else {
+		name := state.Name.ValueString()
+		devices, err := listDeviceStats(ctx, d.client, site)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list devices", err.Error())
+			return
+		}
+		for _, device := range devices {
+			if device.Name != name {
+				continue
+			}
+			if found != nil {
+				resp.Diagnostics.AddError("Multiple devices found", fmt.Sprintf("More than one device is named %q, look it up by MAC address instead", name))
+				return
+			}
+			found = &device
+		}
+		if found == nil {
+			resp.Diagnostics.AddError("Device not found", fmt.Sprintf("No device with name %q found in site %q", name, site))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(state.Merge(ctx, found)...)
+	state.SetSite(site)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,143 @@
+package device
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+type deviceModelDatasourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Model            types.String `tfsdk:"model"`
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	Ports            types.Int64  `tfsdk:"ports"`
+	PoEPorts         []int64      `tfsdk:"poe_ports"`
+	PassthroughPorts []int64      `tfsdk:"passthrough_ports"`
+	SFPPorts         []int64      `tfsdk:"sfp_ports"`
+	Radios           []string     `tfsdk:"radios"`
+}
+
+var (
+	_ datasource.DataSource              = &deviceModelDatasource{}
+	_ datasource.DataSourceWithConfigure = &deviceModelDatasource{}
+	_ base.Resource                      = &deviceModelDatasource{}
+)
+
+type deviceModelDatasource struct {
+	base.ControllerVersionValidator
+	base.FeatureValidator
+	client *base.Client
+}
+
+func NewDeviceModelDatasource() datasource.DataSource {
+	return &deviceModelDatasource{}
+}
+
+func (d *deviceModelDatasource) SetClient(client *base.Client) {
+	d.client = client
+}
+
+func (d *deviceModelDatasource) SetVersionValidator(validator base.ControllerVersionValidator) {
+	d.ControllerVersionValidator = validator
+}
+
+func (d *deviceModelDatasource) SetFeatureValidator(validator base.FeatureValidator) {
+	d.FeatureValidator = validator
+}
+
+func (d *deviceModelDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	base.ConfigureDatasource(d, req, resp)
+}
+
+func (d *deviceModelDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_model"
+}
+
+func (d *deviceModelDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_device_model` data source describes the hardware of a UniFi device model from the catalog " +
+			"embedded in the provider. The same catalog is used to validate `port_override` and `radio` blocks of " +
+			"`unifi_device` and `unifi_device_port` resources at plan time. Models missing from the catalog are not " +
+			"validated by the provider.",
+		Attributes: map[string]schema.Attribute{
+			"id": ut.ID("The model code."),
+			"model": schema.StringAttribute{
+				MarkdownDescription: "The model code as reported by the controller, e.g. `US24P250` or `U7PG2`.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The product name of the model, e.g. `US-24-250W`.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The device type: `usw` (switch), `uap` (access point) or `udm` (gateway console).",
+				Computed:            true,
+			},
+			"ports": schema.Int64Attribute{
+				MarkdownDescription: "The number of configurable ports, numbered from 1.",
+				Computed:            true,
+			},
+			"poe_ports": schema.ListAttribute{
+				MarkdownDescription: "The ports able to supply PoE, i.e. to use the `auto` and `pasv24` PoE modes.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"passthrough_ports": schema.ListAttribute{
+				MarkdownDescription: "The ports passing on the PoE supplied to the device, i.e. able to use the `passthrough` PoE mode.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"sfp_ports": schema.ListAttribute{
+				MarkdownDescription: "The SFP or SFP+ ports.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"radios": schema.ListAttribute{
+				MarkdownDescription: "The radio bands of the device: `ng` (2.4GHz), `na` (5GHz) and `6e` (6GHz).",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *deviceModelDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state deviceModelDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := state.Model.ValueString()
+	m, ok := lookupDeviceModel(model)
+	if !ok {
+		resp.Diagnostics.AddError("Device model not found", fmt.Sprintf("Model %q is not in the device model catalog. Known models: %s", model, strings.Join(knownDeviceModels(), ", ")))
+		return
+	}
+
+	state.ID = types.StringValue(model)
+	state.Name = types.StringValue(m.Name)
+	state.Type = types.StringValue(m.Type)
+	state.Ports = types.Int64Value(int64(m.Ports))
+	state.PoEPorts = toInt64s(m.PoEPorts)
+	state.PassthroughPorts = toInt64s(m.PassthroughPorts)
+	state.SFPPorts = toInt64s(m.SFPPorts)
+	state.Radios = append([]string{}, m.Radios...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func toInt64s(ints []int) []int64 {
+	out := make([]int64, 0, len(ints))
+	for _, i := range ints {
+		out = append(out, int64(i))
+	}
+	return out
+}
@@ -0,0 +1,195 @@
+package device
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
+)
+
+type devicesDatasourceModel struct {
+	Site      ut.SiteValue             `tfsdk:"site"`
+	Type      types.String             `tfsdk:"type"`
+	Model     types.String             `tfsdk:"model"`
+	State     types.String             `tfsdk:"state"`
+	Adopted   types.Bool               `tfsdk:"adopted"`
+	NameRegex types.String             `tfsdk:"name_regex"`
+	UplinkMAC types.String             `tfsdk:"uplink_mac"`
+	Devices   []*deviceDatasourceModel `tfsdk:"result"`
+}
+
+func (m *devicesDatasourceModel) GetSite() string {
+	return m.Site.ValueString()
+}
+
+func (m *devicesDatasourceModel) GetRawSite() types.String {
+	return m.Site.StringValue
+}
+
+func (m *devicesDatasourceModel) SetSite(site string) {
+	m.Site = ut.NewSiteValue(site)
+}
+
+// deviceFilter holds the filters of the unifi_devices data source. Empty
+// fields match every device.
+type deviceFilter struct {
+	Type      string
+	Model     string
+	State     string
+	Adopted   *bool
+	NameRegex *regexp.Regexp
+	UplinkMAC string
+}
+
+func (f deviceFilter) matches(device deviceStat) bool {
+	switch {
+	case f.Type != "" && device.Type != f.Type:
+		return false
+	case f.Model != "" && device.Model != f.Model:
+		return false
+	case f.State != "" && !strings.EqualFold(device.State.String(), f.State):
+		return false
+	case f.Adopted != nil && device.Adopted != *f.Adopted:
+		return false
+	case f.NameRegex != nil && !f.NameRegex.MatchString(device.Name):
+		return false
+	case f.UplinkMAC != "" && utils.CleanMAC(device.Uplink.UplinkMAC) != utils.CleanMAC(f.UplinkMAC):
+		return false
+	}
+	return true
+}
+
+var (
+	_ datasource.DataSource              = &devicesDatasource{}
+	_ datasource.DataSourceWithConfigure = &devicesDatasource{}
+	_ base.Resource                      = &devicesDatasource{}
+)
+
+type devicesDatasource struct {
+	base.ControllerVersionValidator
+	base.FeatureValidator
+	client *base.Client
+}
+
+func NewDevicesDatasource() datasource.DataSource {
+	return &devicesDatasource{}
+}
+
+func (d *devicesDatasource) SetClient(client *base.Client) {
+	d.client = client
+}
+
+func (d *devicesDatasource) SetVersionValidator(validator base.ControllerVersionValidator) {
+	d.ControllerVersionValidator = validator
+}
+
+func (d *devicesDatasource) SetFeatureValidator(validator base.FeatureValidator) {
+	d.FeatureValidator = validator
+}
+
+func (d *devicesDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	base.ConfigureDatasource(d, req, resp)
+}
+
+func (d *devicesDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices"
+}
+
+func (d *devicesDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_devices` data source lists the UniFi devices of a site, optionally filtered. " +
+			"All filters are combined, a device must match every filter set to be returned.",
+		Attributes: map[string]schema.Attribute{
+			"site": ut.SiteAttribute(),
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return devices of this type, e.g. `usw`, `uap`, `ugw` or `udm`.",
+				Optional:            true,
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Only return devices of this model code, e.g. `US24P250`.",
+				Optional:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Only return devices in this state, e.g. `Connected` or `Pending`. Matched case-insensitively.",
+				Optional:            true,
+			},
+			"adopted": schema.BoolAttribute{
+				MarkdownDescription: "Only return adopted (`true`) or not adopted (`false`) devices.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return devices whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"uplink_mac": schema.StringAttribute{
+				MarkdownDescription: "Only return devices uplinked to the device with this MAC address.",
+				Optional:            true,
+			},
+			"result": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching devices.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: deviceDatasourceAttributes(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *devicesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state devicesDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	site, diags := d.client.ResolveSite(&state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := deviceFilter{
+		Type:      state.Type.ValueString(),
+		Model:     state.Model.ValueString(),
+		State:     state.State.ValueString(),
+		UplinkMAC: state.UplinkMAC.ValueString(),
+	}
+	if !state.Adopted.IsNull() {
+		adopted := state.Adopted.ValueBool()
+		filter.Adopted = &adopted
+	}
+	if nameRegex := state.NameRegex.ValueString(); nameRegex != "" {
+		re, err := regexp.Compile(nameRegex)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid name_regex", err.Error())
+			return
+		}
+		filter.NameRegex = re
+	}
+
+	devices, err := listDeviceStats(ctx, d.client, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list devices", err.Error())
+		return
+	}
+
+	state.Devices = []*deviceDatasourceModel{}
+	for _, device := range devices {
+		if !filter.matches(device) {
+			continue
+		}
+		m := &deviceDatasourceModel{}
+		resp.Diagnostics.Append(m.Merge(ctx, &device)...)
+		m.SetSite(site)
+		state.Devices = append(state.Devices, m)
+	}
+	state.SetSite(site)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
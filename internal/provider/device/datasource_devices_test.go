@@ -0,0 +1,78 @@
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDeviceStats(t *testing.T) []deviceStat {
+	t.Helper()
+	var devices []deviceStat
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"_id": "1", "mac": "aa:aa:aa:aa:aa:01", "name": "core-switch", "model": "US24P250", "type": "usw", "adopted": true},
+		{"_id": "2", "mac": "aa:aa:aa:aa:aa:02", "name": "office-ap", "model": "U7PG2", "type": "uap", "adopted": true,
+		 "uplink": {"uplink_mac": "AA:AA:AA:AA:AA:01", "uplink_remote_port": 5, "type": "wire"}},
+		{"_id": "3", "mac": "aa:aa:aa:aa:aa:03", "name": "lobby-ap", "model": "U7PG2", "type": "uap", "adopted": false}
+	]`), &devices))
+	return devices
+}
+
+func filteredIDs(devices []deviceStat, f deviceFilter) []string {
+	var ids []string
+	for _, device := range devices {
+		if f.matches(device) {
+			ids = append(ids, device.ID)
+		}
+	}
+	return ids
+}
+
+func TestDeviceFilter_Matches(t *testing.T) {
+	devices := testDeviceStats(t)
+	adopted := true
+
+	assert.Equal(t, []string{"1", "2", "3"}, filteredIDs(devices, deviceFilter{}))
+	assert.Equal(t, []string{"2", "3"}, filteredIDs(devices, deviceFilter{Type: "uap"}))
+	assert.Equal(t, []string{"1"}, filteredIDs(devices, deviceFilter{Model: "US24P250"}))
+	assert.Equal(t, []string{"1", "2"}, filteredIDs(devices, deviceFilter{Adopted: &adopted}))
+	assert.Equal(t, []string{"3"}, filteredIDs(devices, deviceFilter{NameRegex: regexp.MustCompile("^lobby-")}))
+	assert.Equal(t, []string{"2"}, filteredIDs(devices, deviceFilter{UplinkMAC: "aa:aa:aa:aa:aa:01"}))
+	assert.Equal(t, []string{"2"}, filteredIDs(devices, deviceFilter{Type: "uap", Adopted: &adopted}))
+
+	devices[0].State = unifi.DeviceStateConnected
+	devices[1].State = unifi.DeviceStatePending
+	devices[2].State = unifi.DeviceStatePending
+	assert.Equal(t, []string{"1"}, filteredIDs(devices, deviceFilter{State: strings.ToUpper(unifi.DeviceStateConnected.String())}))
+}
+
+func TestDeviceDatasourceModel_Merge(t *testing.T) {
+	device := deviceStat{ID: "2", MAC: "aa:aa:aa:aa:aa:02", Name: "office-ap", Model: "U7PG2", Type: "uap", IP: "10.0.0.5", Version: "6.6.77"}
+	device.Uplink.UplinkMAC = "aa:aa:aa:aa:aa:01"
+	device.Uplink.UplinkRemotePort = 5
+	device.PortTable = []devicePortStat{{PortIDX: 1, Name: "Port 1", Up: true, Speed: 1000, IsUplink: true}}
+
+	var m deviceDatasourceModel
+	require.False(t, m.Merge(context.Background(), &device).HasError())
+	assert.Equal(t, "2", m.ID.ValueString())
+	assert.Equal(t, "U7PG2", m.DeviceModel.ValueString())
+	assert.Equal(t, "10.0.0.5", m.IP.ValueString())
+	assert.Equal(t, "6.6.77", m.Version.ValueString())
+	assert.Equal(t, int64(5), m.UplinkPort.ValueInt64())
+	require.Len(t, m.PortTable, 1)
+	assert.Equal(t, int64(1000), m.PortTable[0].Speed.ValueInt64())
+	assert.True(t, m.PortTable[0].IsUplink.ValueBool())
+
+	assert.True(t, m.Merge(context.Background(), &unifi.Device{}).HasError())
+}
+
+func TestDeviceStat_PortCount(t *testing.T) {
+	assert.Equal(t, 0, (&deviceStat{}).portCount())
+	assert.Equal(t, 26, (&deviceStat{PortTable: []devicePortStat{{PortIDX: 1}, {PortIDX: 26}, {PortIDX: 25}}}).portCount())
+}
@@ -0,0 +1,22 @@
+package device
+
+import (
+	"sync"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
+)
+
+// deviceLocks serialises read-modify-write cycles of a single device's
+// configuration. unifi_device and unifi_device_port resources of the same
+// device each PUT the whole `port_overrides` array, so concurrent applies would
+// otherwise overwrite each other's ports.
+var deviceLocks sync.Map
+
+// lockDevice locks the device with the given MAC in the given site and returns
+// the function releasing the lock.
+func lockDevice(site, mac string) func() {
+	v, _ := deviceLocks.LoadOrStore(site+"/"+utils.CleanMAC(mac), &sync.Mutex{})
+	mu, _ := v.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
@@ -0,0 +1,219 @@
+package device
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
+)
+
+// deviceModel describes the hardware of a UniFi device model. Ports are
+// numbered from 1, as in `port_override.number`.
+// PoE passthrough ports pass on the power the device itself is supplied with,
+// and only support the `passthrough` PoE mode.
+type deviceModel struct {
+	Name             string   `json:"name"`
+	Type             string   `json:"type"`
+	Ports            int      `json:"ports"`
+	PoEPorts         []int    `json:"poe_ports"`
+	PassthroughPorts []int    `json:"passthrough_ports"`
+	SFPPorts         []int    `json:"sfp_ports"`
+	Radios           []string `json:"radios"`
+}
+
+//go:embed models.json
+var deviceModelsJSON []byte
+
+// deviceModels is the embedded catalog of known device models, keyed by the
+// `model` string the controller reports for a device.
+var deviceModels = func() map[string]deviceModel {
+	models := map[string]deviceModel{}
+	if err := json.Unmarshal(deviceModelsJSON, &models); err != nil {
+		panic(fmt.Sprintf("invalid embedded device model catalog: %v", err))
+	}
+	return models
+}()
+
+// lookupDeviceModel returns the catalog entry of a model, if known.
+func lookupDeviceModel(model string) (deviceModel, bool) {
+	m, ok := deviceModels[model]
+	return m, ok
+}
+
+// knownDeviceModels returns the sorted model codes of the catalog.
+func knownDeviceModels() []string {
+	models := make([]string, 0, len(deviceModels))
+	for model := range deviceModels {
+		models = append(models, model)
+	}
+	slices.Sort(models)
+	return models
+}
+
+// validateDeviceAgainstModel checks port overrides and radio bands against the
+// hardware of the device model.
+func validateDeviceAgainstModel(model string, m deviceModel, pos []unifi.DevicePortOverrides, radios []string) error {
+	if len(pos) > 0 && m.Ports == 0 {
+		return fmt.Errorf("model %s (%s) has no configurable ports", m.Name, model)
+	}
+	if err := validatePortOverrideLayout(pos, m.Ports); err != nil {
+		return fmt.Errorf("model %s (%s): %w", m.Name, model, err)
+	}
+	for _, po := range pos {
+		switch po.PoeMode {
+		case "", "off":
+		case "passthrough":
+			if !slices.Contains(m.PassthroughPorts, po.PortIDX) {
+				return fmt.Errorf("model %s (%s): port %d does not support PoE passthrough", m.Name, model, po.PortIDX)
+			}
+		default:
+			if !slices.Contains(m.PoEPorts, po.PortIDX) {
+				return fmt.Errorf("model %s (%s): port %d does not support PoE", m.Name, model, po.PortIDX)
+			}
+		}
+	}
+	for _, radio := range radios {
+		if !slices.Contains(m.Radios, radio) {
+			if len(m.Radios) == 0 {
+				return fmt.Errorf("model %s (%s) has no radios", m.Name, model)
+			}
+			return fmt.Errorf("model %s (%s) has no %q radio, supported radios are: %s", m.Name, model, radio, strings.Join(m.Radios, ", "))
+		}
+	}
+	return nil
+}
+
+// deviceModelOf returns the model of the adopted device with the given MAC.
+// An empty model is returned when the device is not known to the controller
+// (yet), so plan-time validation is skipped rather than failing the plan.
+func deviceModelOf(ctx context.Context, c *base.Client, site, mac string) (string, error) {
+	device, err := c.GetDeviceByMAC(ctx, site, utils.CleanMAC(mac))
+	if errors.Is(err, unifi.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if device == nil {
+		return "", nil
+	}
+	return device.Model, nil
+}
+
+// customizeDeviceModel validates the declared ports and radios of unifi_device
+// against the device model catalog. Unknown values and models missing from the
+// catalog are skipped.
+func customizeDeviceModel(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return customizeAgainstModel(ctx, d, meta, "mac", []string{"port_override", "radio"}, func(raw cty.Value) ([]unifi.DevicePortOverrides, []string, error) {
+		pos, err := rawPortOverrides(raw)
+		if err != nil {
+			return nil, nil, err
+		}
+		return pos, rawRadioNames(raw), nil
+	})
+}
+
+// customizeDevicePortModel validates a unifi_device_port against the device
+// model catalog.
+func customizeDevicePortModel(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return customizeAgainstModel(ctx, d, meta, "device_mac", []string{"number", "op_mode", "poe_mode", "aggregate_num_ports", "mirror_port_idx"}, func(raw cty.Value) ([]unifi.DevicePortOverrides, []string, error) {
+		po, ok, err := portOverrideFromRaw(raw)
+		if err != nil || !ok {
+			return nil, nil, err
+		}
+		return []unifi.DevicePortOverrides{po}, nil, nil
+	})
+}
+
+// customizeAgainstModel looks the device up only when it is created or when
+// the MAC, site or one of the validated attributes changed, so plans of
+// unchanged resources make no request for it.
+func customizeAgainstModel(ctx context.Context, d *schema.ResourceDiff, meta interface{}, macAttribute string, attributes []string, declared func(cty.Value) ([]unifi.DevicePortOverrides, []string, error)) error {
+	c, ok := meta.(*base.Client)
+	if !ok {
+		return fmt.Errorf("unexpected meta type: %T", meta)
+	}
+	mac, _ := d.Get(macAttribute).(string)
+	if mac == "" || !d.NewValueKnown(macAttribute) {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChanges(append([]string{macAttribute, "site"}, attributes...)...) {
+		return nil
+	}
+	pos, radios, err := declared(d.GetRawConfig())
+	if err != nil || (len(pos) == 0 && len(radios) == 0) {
+		return err
+	}
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return err
+	}
+	model, err := deviceModelOf(ctx, c, site, mac)
+	if err != nil {
+		return fmt.Errorf("unable to look up device %q: %w", mac, err)
+	}
+	m, ok := lookupDeviceModel(model)
+	if !ok {
+		return nil
+	}
+	return validateDeviceAgainstModel(model, m, pos, radios)
+}
+
+// rawPortOverrides returns the known `port_override` blocks of a raw
+// unifi_device configuration.
+func rawPortOverrides(raw cty.Value) ([]unifi.DevicePortOverrides, error) {
+	if raw.IsNull() || !raw.Type().HasAttribute("port_override") {
+		return nil, nil
+	}
+	blocks := raw.GetAttr("port_override")
+	if blocks.IsNull() || !blocks.IsKnown() {
+		return nil, nil
+	}
+	var pos []unifi.DevicePortOverrides
+	for it := blocks.ElementIterator(); it.Next(); {
+		_, block := it.Element()
+		po, ok, err := portOverrideFromRaw(block)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			pos = append(pos, po)
+		}
+	}
+	return pos, nil
+}
+
+// rawRadioNames returns the known band names of the `radio` blocks of a raw
+// unifi_device configuration.
+func rawRadioNames(raw cty.Value) []string {
+	if raw.IsNull() || !raw.Type().HasAttribute("radio") {
+		return nil
+	}
+	blocks := raw.GetAttr("radio")
+	if blocks.IsNull() || !blocks.IsKnown() {
+		return nil
+	}
+	var names []string
+	for it := blocks.ElementIterator(); it.Next(); {
+		_, block := it.Element()
+		if block.IsNull() || !block.IsKnown() {
+			continue
+		}
+		name := block.GetAttr("name")
+		if name.IsNull() || !name.IsKnown() {
+			continue
+		}
+		names = append(names, name.AsString())
+	}
+	return names
+}
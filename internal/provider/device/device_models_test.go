@@ -0,0 +1,136 @@
+package device
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceModelCatalog_Consistent(t *testing.T) {
+	require.NotEmpty(t, deviceModels)
+	for model, m := range deviceModels {
+		assert.NotEmpty(t, m.Name, model)
+		assert.Contains(t, []string{"usw", "uap", "udm"}, m.Type, model)
+		for _, port := range slices.Concat(m.PoEPorts, m.PassthroughPorts, m.SFPPorts) {
+			assert.True(t, port >= 1 && port <= m.Ports, "%s: port %d out of range", model, port)
+		}
+		for _, radio := range m.Radios {
+			assert.Contains(t, []string{"ng", "na", "6e"}, radio, model)
+		}
+	}
+}
+
+func TestValidateDeviceAgainstModel(t *testing.T) {
+	switchModel, ok := lookupDeviceModel("USL8LP")
+	require.True(t, ok)
+	apModel, ok := lookupDeviceModel("U7PG2")
+	require.True(t, ok)
+	ap6GHzModel, ok := lookupDeviceModel("U7PRO")
+	require.True(t, ok)
+	passthroughModel, ok := lookupDeviceModel("US8")
+	require.True(t, ok)
+
+	tests := []struct {
+		name    string
+		model   deviceModel
+		pos     []unifi.DevicePortOverrides
+		radios  []string
+		wantErr string
+	}{
+		{
+			name:  "valid ports",
+			model: switchModel,
+			pos:   []unifi.DevicePortOverrides{{PortIDX: 1, PoeMode: "auto"}, {PortIDX: 8, PoeMode: "off"}},
+		},
+		{
+			name:    "port beyond the last port",
+			model:   switchModel,
+			pos:     []unifi.DevicePortOverrides{{PortIDX: 9}},
+			wantErr: "port 9 does not exist",
+		},
+		{
+			name:    "poe on a non-PoE port",
+			model:   switchModel,
+			pos:     []unifi.DevicePortOverrides{{PortIDX: 6, PoeMode: "auto"}},
+			wantErr: "port 6 does not support PoE",
+		},
+		{
+			name:    "passthrough on a PoE port",
+			model:   switchModel,
+			pos:     []unifi.DevicePortOverrides{{PortIDX: 1, PoeMode: "passthrough"}},
+			wantErr: "port 1 does not support PoE passthrough",
+		},
+		{
+			name:  "passthrough on a passthrough port",
+			model: passthroughModel,
+			pos:   []unifi.DevicePortOverrides{{PortIDX: 8, PoeMode: "passthrough"}},
+		},
+		{
+			name:    "passthrough on another port",
+			model:   passthroughModel,
+			pos:     []unifi.DevicePortOverrides{{PortIDX: 7, PoeMode: "passthrough"}},
+			wantErr: "port 7 does not support PoE passthrough",
+		},
+		{
+			name:    "poe on a passthrough port",
+			model:   passthroughModel,
+			pos:     []unifi.DevicePortOverrides{{PortIDX: 8, PoeMode: "auto"}},
+			wantErr: "port 8 does not support PoE",
+		},
+		{
+			name:    "lag beyond the last port",
+			model:   switchModel,
+			pos:     []unifi.DevicePortOverrides{{PortIDX: 7, OpMode: "aggregate", AggregateMembers: []int{7, 8, 9}}},
+			wantErr: "spans ports 7-9",
+		},
+		{
+			name:   "supported radios",
+			model:  apModel,
+			radios: []string{"ng", "na"},
+		},
+		{
+			name:    "unsupported radio",
+			model:   apModel,
+			radios:  []string{"6e"},
+			wantErr: `no "6e" radio`,
+		},
+		{
+			name:   "6 GHz radio",
+			model:  ap6GHzModel,
+			radios: []string{"ng", "na", "6e"},
+		},
+		{
+			name:    "radio on a switch",
+			model:   switchModel,
+			radios:  []string{"ng"},
+			wantErr: "has no radios",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDeviceAgainstModel("test", tt.model, tt.pos, tt.radios)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRawRadioNames_SkipsUnknown(t *testing.T) {
+	radioType := cty.Object(map[string]cty.Type{"name": cty.String})
+	raw := cty.ObjectVal(map[string]cty.Value{
+		"radio": cty.SetVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("na")}),
+			cty.ObjectVal(map[string]cty.Value{"name": cty.UnknownVal(cty.String)}),
+		}),
+	})
+	assert.Equal(t, []string{"na"}, rawRadioNames(raw))
+	assert.Empty(t, rawRadioNames(cty.ObjectVal(map[string]cty.Value{"radio": cty.NullVal(cty.Set(radioType))})))
+}
@@ -0,0 +1,79 @@
+package device
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/filipowm/go-unifi/unifi"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
+)
+
+// deviceStat is a device as returned by the controller's device statistics
+// endpoint, the same one the go-unifi device calls use. unifi.Device only
+// models the configurable fields, so runtime details such as the IP address,
+// firmware version, uplink and port table are read through this type.
+type deviceStat struct {
+	ID       string            `json:"_id"`
+	MAC      string            `json:"mac"`
+	Name     string            `json:"name"`
+	Model    string            `json:"model"`
+	Type     string            `json:"type"`
+	State    unifi.DeviceState `json:"state"`
+	Adopted  bool              `json:"adopted"`
+	Disabled bool              `json:"disabled"`
+	IP       string            `json:"ip"`
+	Version  string            `json:"version"`
+	Uplink   struct {
+		UplinkMAC        string `json:"uplink_mac"`
+		UplinkRemotePort int    `json:"uplink_remote_port"`
+		Type             string `json:"type"`
+	} `json:"uplink"`
+	PortTable []devicePortStat `json:"port_table"`
+}
+
+type devicePortStat struct {
+	PortIDX    int    `json:"port_idx"`
+	Name       string `json:"name"`
+	Enable     bool   `json:"enable"`
+	Up         bool   `json:"up"`
+	Speed      int    `json:"speed"`
+	FullDuplex bool   `json:"full_duplex"`
+	IsUplink   bool   `json:"is_uplink"`
+	PoeEnable  bool   `json:"poe_enable"`
+	Media      string `json:"media"`
+}
+
+// listDeviceStats lists all devices of the site.
+func listDeviceStats(ctx context.Context, c unifi.Client, site string) ([]deviceStat, error) {
+	var resp base.RestResponse[deviceStat]
+	if err := c.Do(ctx, http.MethodGet, fmt.Sprintf("s/%s/stat/device", site), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// getDeviceStatByMAC reads a single device of the site, returning
+// unifi.ErrNotFound when the controller does not know the MAC.
+func getDeviceStatByMAC(ctx context.Context, c unifi.Client, site, mac string) (*deviceStat, error) {
+	var resp base.RestResponse[deviceStat]
+	if err := c.Do(ctx, http.MethodGet, fmt.Sprintf("s/%s/stat/device/%s", site, utils.CleanMAC(mac)), nil, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Data) != 1 {
+		return nil, unifi.ErrNotFound
+	}
+	return &resp.Data[0], nil
+}
+
+// portCount returns the highest port number in the device's port table, or 0
+// when the device reports no ports.
+func (s *deviceStat) portCount() int {
+	count := 0
+	for _, port := range s.PortTable {
+		count = max(count, port.PortIDX)
+	}
+	return count
+}
@@ -0,0 +1,132 @@
+package device
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validatePortOverrideMode checks that the mirroring and aggregation settings of
+// a single port override match its operating mode.
+func validatePortOverrideMode(po unifi.DevicePortOverrides) error {
+	switch po.OpMode {
+	case "mirror":
+		if po.MirrorPortIDX == 0 {
+			return fmt.Errorf("port %d: %q is required when %q is %q", po.PortIDX, "mirror_port_idx", "op_mode", "mirror")
+		}
+		if po.MirrorPortIDX == po.PortIDX {
+			return fmt.Errorf("port %d: a port cannot mirror itself", po.PortIDX)
+		}
+	default:
+		if po.MirrorPortIDX != 0 {
+			return fmt.Errorf("port %d: %q is only valid when %q is %q", po.PortIDX, "mirror_port_idx", "op_mode", "mirror")
+		}
+	}
+	if len(po.AggregateMembers) > 0 && po.OpMode != "aggregate" {
+		return fmt.Errorf("port %d: %q is only valid when %q is %q", po.PortIDX, "aggregate_num_ports", "op_mode", "aggregate")
+	}
+	return nil
+}
+
+// validatePortOverrideLayout checks the port overrides of a device as a whole:
+// LAGs must not overlap, LAG members other than the first port must not carry
+// their own override, and every referenced port must exist on the device. A
+// portCount of 0 means the device's port count is unknown and skips the range
+// checks.
+func validatePortOverrideLayout(pos []unifi.DevicePortOverrides, portCount int) error {
+	lagOf := map[int]int{}
+	for _, po := range pos {
+		if portCount > 0 && po.PortIDX > portCount {
+			return fmt.Errorf("port %d does not exist, the device has %d ports", po.PortIDX, portCount)
+		}
+		for _, member := range po.AggregateMembers {
+			if portCount > 0 && member > portCount {
+				return fmt.Errorf("the LAG on port %d spans ports %d-%d, but the device has %d ports",
+					po.PortIDX, po.AggregateMembers[0], po.AggregateMembers[len(po.AggregateMembers)-1], portCount)
+			}
+			if owner, ok := lagOf[member]; ok {
+				return fmt.Errorf("port %d is a member of both the LAG on port %d and the LAG on port %d", member, owner, po.PortIDX)
+			}
+			lagOf[member] = po.PortIDX
+		}
+		if portCount > 0 && po.MirrorPortIDX > portCount {
+			return fmt.Errorf("port %d mirrors port %d, but the device has %d ports", po.PortIDX, po.MirrorPortIDX, portCount)
+		}
+	}
+	for _, po := range pos {
+		if owner, ok := lagOf[po.PortIDX]; ok && owner != po.PortIDX {
+			return fmt.Errorf("port %d is a member of the LAG on port %d and cannot be overridden separately", po.PortIDX, owner)
+		}
+	}
+	return nil
+}
+
+func customizeDevicePortOverrides(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	return validatePortOverridesRawConfig(d.GetRawConfig())
+}
+
+// validatePortOverridesRawConfig validates the `port_override` blocks of a
+// unifi_device configuration at plan time. Blocks whose number, mode, LAG size
+// or mirrored port are not known yet are skipped; they are validated again
+// once known, and against the device's port count on apply.
+func validatePortOverridesRawConfig(raw cty.Value) error {
+	pos, err := rawPortOverrides(raw)
+	if err != nil {
+		return err
+	}
+	return validatePortOverrideLayout(pos, 0)
+}
+
+func customizeDevicePort(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	_, _, err := portOverrideFromRaw(d.GetRawConfig())
+	return err
+}
+
+// portOverrideFromRaw converts the layout- and hardware-relevant attributes of
+// a raw port override into a DevicePortOverrides and validates its mode. ok is
+// false when any of them is not known yet.
+func portOverrideFromRaw(raw cty.Value) (po unifi.DevicePortOverrides, ok bool, err error) {
+	if raw.IsNull() || !raw.IsKnown() {
+		return po, false, nil
+	}
+	data := map[string]interface{}{"op_mode": "switch"}
+	for _, attr := range []string{"number", "op_mode", "poe_mode", "aggregate_num_ports", "mirror_port_idx"} {
+		if !raw.Type().HasAttribute(attr) {
+			continue
+		}
+		v := raw.GetAttr(attr)
+		if !v.IsKnown() {
+			return po, false, nil
+		}
+		if v.IsNull() {
+			continue
+		}
+		if v.Type() == cty.String {
+			data[attr] = v.AsString()
+			continue
+		}
+		n, _ := v.AsBigFloat().Int64()
+		data[attr] = int(n)
+	}
+	if _, ok := data["number"]; !ok {
+		return po, false, nil
+	}
+	po, err = toPortOverride(data)
+	if err != nil {
+		return po, false, err
+	}
+	return po, true, nil
+}
+
+// devicePortCount returns the number of ports of the device reported by the
+// controller, or 0 when the device reports no port table.
+func devicePortCount(ctx context.Context, c unifi.Client, site, mac string) (int, error) {
+	stat, err := getDeviceStatByMAC(ctx, c, site, mac)
+	if err != nil {
+		return 0, err
+	}
+	return stat.portCount(), nil
+}
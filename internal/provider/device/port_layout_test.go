@@ -0,0 +1,182 @@
+package device
+
+import (
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToPortOverride_Mirror(t *testing.T) {
+	po, err := toPortOverride(map[string]interface{}{
+		"number":          8,
+		"op_mode":         "mirror",
+		"mirror_port_idx": 3,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, po.MirrorPortIDX)
+	assert.Equal(t, 3, fromPortOverride(po)["mirror_port_idx"])
+
+	_, err = toPortOverride(map[string]interface{}{"number": 8, "op_mode": "mirror"})
+	require.Error(t, err, "mirror mode without a mirrored port")
+
+	_, err = toPortOverride(map[string]interface{}{"number": 8, "op_mode": "mirror", "mirror_port_idx": 8})
+	require.Error(t, err, "port mirroring itself")
+
+	_, err = toPortOverride(map[string]interface{}{"number": 8, "op_mode": "switch", "mirror_port_idx": 3})
+	require.Error(t, err, "mirrored port outside mirror mode")
+}
+
+func TestToPortOverride_AggregateRequiresAggregateMode(t *testing.T) {
+	_, err := toPortOverride(map[string]interface{}{"number": 1, "op_mode": "switch", "aggregate_num_ports": 2})
+	require.Error(t, err)
+}
+
+func TestValidatePortOverrideLayout(t *testing.T) {
+	lag := unifi.DevicePortOverrides{PortIDX: 5, OpMode: "aggregate", AggregateMembers: []int{5, 6, 7}}
+
+	tests := []struct {
+		name      string
+		pos       []unifi.DevicePortOverrides
+		portCount int
+		wantErr   string
+	}{
+		{
+			name:      "lag with unrelated ports",
+			pos:       []unifi.DevicePortOverrides{lag, {PortIDX: 1}, {PortIDX: 8}},
+			portCount: 8,
+		},
+		{
+			name:    "lag member overridden separately",
+			pos:     []unifi.DevicePortOverrides{lag, {PortIDX: 6, Name: "member"}},
+			wantErr: "port 6 is a member of the LAG on port 5",
+		},
+		{
+			name:    "overlapping lags",
+			pos:     []unifi.DevicePortOverrides{lag, {PortIDX: 3, OpMode: "aggregate", AggregateMembers: []int{3, 4, 5}}},
+			wantErr: "port 5 is a member of both",
+		},
+		{
+			name:      "lag beyond the last port",
+			pos:       []unifi.DevicePortOverrides{lag},
+			portCount: 6,
+			wantErr:   "spans ports 5-7, but the device has 6 ports",
+		},
+		{
+			name: "unknown port count skips range checks",
+			pos:  []unifi.DevicePortOverrides{lag, {PortIDX: 52}},
+		},
+		{
+			name:      "port beyond the last port",
+			pos:       []unifi.DevicePortOverrides{{PortIDX: 9}},
+			portCount: 8,
+			wantErr:   "port 9 does not exist",
+		},
+		{
+			name:      "mirrored port beyond the last port",
+			pos:       []unifi.DevicePortOverrides{{PortIDX: 1, OpMode: "mirror", MirrorPortIDX: 9}},
+			portCount: 8,
+			wantErr:   "mirrors port 9",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePortOverrideLayout(tt.pos, tt.portCount)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+var rawPortOverrideType = cty.Object(map[string]cty.Type{
+	"number":              cty.Number,
+	"op_mode":             cty.String,
+	"aggregate_num_ports": cty.Number,
+	"mirror_port_idx":     cty.Number,
+})
+
+func rawPortOverride(number int64, opMode cty.Value, aggregate, mirror cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"number":              cty.NumberIntVal(number),
+		"op_mode":             opMode,
+		"aggregate_num_ports": aggregate,
+		"mirror_port_idx":     mirror,
+	})
+}
+
+func TestValidatePortOverridesRawConfig(t *testing.T) {
+	rawConfig := func(blocks ...cty.Value) cty.Value {
+		set := cty.SetValEmpty(rawPortOverrideType)
+		if len(blocks) > 0 {
+			set = cty.SetVal(blocks)
+		}
+		return cty.ObjectVal(map[string]cty.Value{"port_override": set})
+	}
+	nullNumber := cty.NullVal(cty.Number)
+	nullString := cty.NullVal(cty.String)
+	aggregate := cty.StringVal("aggregate")
+
+	tests := []struct {
+		name    string
+		raw     cty.Value
+		wantErr bool
+	}{
+		{
+			name: "no blocks",
+			raw:  rawConfig(),
+		},
+		{
+			name: "lag and unrelated port",
+			raw: rawConfig(
+				rawPortOverride(1, aggregate, cty.NumberIntVal(2), nullNumber),
+				rawPortOverride(3, nullString, nullNumber, nullNumber),
+			),
+		},
+		{
+			name: "lag member overridden",
+			raw: rawConfig(
+				rawPortOverride(1, aggregate, cty.NumberIntVal(2), nullNumber),
+				rawPortOverride(2, nullString, nullNumber, nullNumber),
+			),
+			wantErr: true,
+		},
+		{
+			name: "unknown lag size is skipped",
+			raw: rawConfig(
+				rawPortOverride(1, aggregate, cty.UnknownVal(cty.Number), nullNumber),
+				rawPortOverride(2, nullString, nullNumber, nullNumber),
+			),
+		},
+		{
+			name:    "mirror without mirrored port",
+			raw:     rawConfig(rawPortOverride(4, cty.StringVal("mirror"), nullNumber, nullNumber)),
+			wantErr: true,
+		},
+		{
+			name:    "aggregate size on default mode",
+			raw:     rawConfig(rawPortOverride(4, nullString, cty.NumberIntVal(2), nullNumber)),
+			wantErr: true,
+		},
+		{
+			name: "null raw config",
+			raw:  cty.NullVal(cty.Object(map[string]cty.Type{"port_override": cty.Set(rawPortOverrideType)})),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePortOverridesRawConfig(tt.raw)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@
 
 	"github.com/filipowm/go-unifi/unifi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -25,7 +27,9 @@ func ResourceDevice() *schema.Resource {
 			"Devices must first be adopted by the UniFi controller before they can be managed through Terraform. " +
 			"This resource cannot create new devices, but instead allows you to manage existing devices that have already been adopted. " +
 			"The recommended approach is to adopt devices through the UniFi controller UI first, then import them into Terraform using the device's MAC address.\n\n" +
-			"This resource supports managing device names, port configurations, and other device-specific settings.",
+			"This resource supports managing device names, port configurations, and other device-specific settings. " +
+			"For device models known to the provider (see the `unifi_device_model` data source), port overrides and radio " +
+			"bands are validated against the hardware at plan time.",
 
 		CreateContext: resourceDeviceCreate,
 		ReadContext:   resourceDeviceRead,
@@ -34,6 +38,10 @@ func ResourceDevice() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDeviceImport,
 		},
+		CustomizeDiff: customdiff.All(
+			customizeDevicePortOverrides,
+			customizeDeviceModel,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -42,11 +50,13 @@ func ResourceDevice() *schema.Resource {
 				Computed:    true,
 			},
 			"site": {
-				Description: "The name of the UniFi site where the device is located. If not specified, the default site will be used.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				ForceNew:    true,
+				Description: "The name of the UniFi site where the device is located. If not specified, the default site will be used. " +
+					"Changing it moves the device to the new site without forgetting it, so it does not need to be adopted again. " +
+					"`unifi_device_port` resources of the device follow the move when their `site` is set from this attribute.",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: utils.SiteDiffSuppressFunc,
 			},
 			"mac": {
 				Description:      "The MAC address of the device in standard format (e.g., 'aa:bb:cc:dd:ee:ff'). This is used to identify and manage specific devices that have already been adopted by the controller.",
@@ -87,6 +97,17 @@ func ResourceDevice() *schema.Resource {
 					return old == "true" && newValue == "false"
 				},
 			},
+			"band_steering_mode": {
+				Description: "Band steering mode for access points, nudging dual-band clients towards the less congested band. Valid values are:\n" +
+					"  * `off` - Band steering is disabled\n" +
+					"  * `equal` - Balance clients between 2.4GHz and 5GHz\n" +
+					"  * `prefer_5g` - Steer capable clients to 5GHz\n\n" +
+					"Has no effect on switches and gateways.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"off", "equal", "prefer_5g"}, false),
+			},
 			"port_override": {
 				// TODO: this should really be a map or something when possible in the SDK
 				// see https://github.com/hashicorp/terraform-plugin-sdk/issues/62
@@ -100,10 +121,11 @@ func ResourceDevice() *schema.Resource {
 					"  * Configuring PoE settings for powered devices\n" +
 					"  * Creating mirrored ports for network monitoring\n" +
 					"  * Setting up link aggregation between switches or servers\n\n" +
-					"**Warning:** the controller stores port overrides as a single array on the device and the provider replaces the " +
-					"entire array on every apply. Any port whose override is set outside Terraform (e.g. via the UniFi UI or another " +
-					"tool) and is NOT declared here will have its override reset to the controller default on the next apply. Declare " +
-					"every port you want overridden.\n\n" +
+					"**Ownership:** the controller stores port overrides as a single array on the device. This resource only manages " +
+					"the ports declared here: ports overridden outside Terraform, or by a `unifi_device_port` resource, are left " +
+					"untouched, while removing a block resets that port's override to the controller default. Do not declare the " +
+					"same port both here and in a `unifi_device_port` resource. Importing the device manages no ports; the ports " +
+					"declared here are taken over by the next apply.\n\n" +
 					"**Tagged-VLAN model:** there is no positive \"allowed VLANs\" list. With `forward = \"customize\"`, tagged traffic is " +
 					"*all* networks **minus** the ones listed in `excluded_network_ids`, so an empty `excluded_network_ids` means \"trunk " +
 					"everything\", not \"trunk nothing\".",
@@ -115,158 +137,11 @@ func ResourceDevice() *schema.Resource {
 				// the user never declared them on. Hashing the whole element would
 				// let such an echo change an element's identity and churn the set
 				// (perpetual add/remove diff). Combined with the Optional+Computed
-				// VLAN attributes below, an undeclared field reads back the
+				// VLAN attributes of portOverrideSchema, an undeclared field reads back the
 				// controller value without producing a diff.
 				Set: portOverrideSetHash,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"number": {
-							Description: "The physical port number on the switch to configure.",
-							Type:        schema.TypeInt,
-							Required:    true,
-						},
-						"name": {
-							Description: "A friendly name for the port that will be displayed in the UniFi controller UI. Examples:\n" +
-								"  * 'Uplink to Core Switch'\n" +
-								"  * 'Conference Room AP'\n" +
-								"  * 'Server LACP Group 1'\n" +
-								"  * 'VoIP Phone Port'",
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"port_profile_id": {
-							Description: "The ID of a pre-configured port profile to apply to this port. Port profiles define settings like VLANs, PoE, and other port-specific configurations.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"op_mode": {
-							Description: "The operating mode of the port. Valid values are:\n" +
-								"  * `switch` - Normal switching mode (default)\n" +
-								"    - Standard port operation for connecting devices\n" +
-								"    - Supports VLANs and all standard switching features\n" +
-								"  * `mirror` - Port mirroring for traffic analysis\n" +
-								"    - Copies traffic from other ports for monitoring\n" +
-								"    - Useful for network troubleshooting and security\n" +
-								"  * `aggregate` - Link aggregation/bonding mode\n" +
-								"    - Combines multiple ports for increased bandwidth\n" +
-								"    - Used for switch uplinks or high-bandwidth servers",
-							Type:         schema.TypeString,
-							Optional:     true,
-							Default:      "switch",
-							ValidateFunc: validation.StringInSlice([]string{"switch", "mirror", "aggregate"}, false),
-							DiffSuppressFunc: func(k, old, newValue string, d *schema.ResourceData) bool {
-								if old == "" && newValue == "switch" {
-									return true
-								}
-								return false
-							},
-						},
-						"poe_mode": {
-							Description: "The Power over Ethernet (PoE) mode for the port. Valid values are:\n" +
-								"* `auto` - Automatically detect and power PoE devices (recommended)\n" +
-								"  - Provides power based on device negotiation\n" +
-								"  - Safest option for most PoE devices\n" +
-								"* `pasv24` - Passive 24V PoE\n" +
-								"  - For older UniFi devices requiring passive 24V\n" +
-								"  - Use with caution to avoid damage\n" +
-								"* `passthrough` - PoE passthrough mode\n" +
-								"  - For daisy-chaining PoE devices\n" +
-								"  - Available on select UniFi switches\n" +
-								"* `off` - Disable PoE on the port\n" +
-								"  - For non-PoE devices\n" +
-								"  - To prevent unwanted power delivery",
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice([]string{"auto", "pasv24", "passthrough", "off"}, false),
-						},
-						"aggregate_num_ports": {
-							Description: "The number of ports to include in a link aggregation group (LAG). Valid range: 2-8 ports. Used when:\n" +
-								"* Creating switch-to-switch uplinks for increased bandwidth\n" +
-								"* Setting up high-availability connections\n" +
-								"* Connecting to servers requiring more bandwidth\n" +
-								"Note: All ports in the LAG must be sequential and have matching configurations.",
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(2, 8),
-							DiffSuppressFunc: func(k, old, newValue string, d *schema.ResourceData) bool {
-								if old == strconv.Itoa(0) && newValue == "" {
-									return true
-								}
-								return false
-							},
-						},
-						"native_networkconf_id": {
-							Description: "The ID of the network to use as the native (untagged) network on this port. " +
-								"This is typically used for:\n" +
-								"* Access ports where devices need untagged access\n" +
-								"* Trunk ports to specify the native VLAN\n" +
-								"* Management networks for network devices\n\n" +
-								"Computed when not set, so the controller's current value (which it may auto-populate on a port) " +
-								"is preserved without producing a diff. Note: the underlying field uses `omitempty`, so once set it " +
-								"cannot be cleared back to empty through Terraform — change it to another network ID instead.",
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-						},
-						"tagged_vlan_mgmt": {
-							Description: "VLAN tagging behavior for the port. Valid values are:\n" +
-								"* `auto` - Automatically handle VLAN tags (recommended)\n" +
-								"* `block_all` - Block all VLAN tagged traffic\n" +
-								"* `custom` - Custom VLAN configuration (use with `forward = \"customize\"` and `excluded_network_ids`)\n\n" +
-								"Computed when not set, so the controller's current value is preserved without producing a diff. " +
-								"Note: the underlying field uses `omitempty`, so once set it cannot be cleared back to empty " +
-								"through Terraform — change it to another value instead.",
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringInSlice([]string{"auto", "block_all", "custom"}, false),
-						},
-						"forward": {
-							Description: "VLAN forwarding mode for the port. Valid values are:\n" +
-								"  * `all` - Forward all VLANs (trunk port)\n" +
-								"  * `native` - Only forward untagged traffic (access port)\n" +
-								"  * `customize` - Forward selected VLANs (use with `excluded_network_ids`)\n" +
-								"  * `disabled` - Disable VLAN forwarding\n\n" +
-								"This attribute has NO default: leaving it unset keeps the port's existing forwarding behavior " +
-								"(the value is computed from the controller). Note: the underlying field uses `omitempty`, so once " +
-								"set it cannot be cleared back to empty through Terraform — change it to another value instead.",
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringInSlice([]string{"all", "native", "customize", "disabled"}, false),
-						},
-						"excluded_network_ids": {
-							Description: "Set of network IDs to exclude when `forward = \"customize\"`. Tagged traffic on the port is " +
-								"*all* networks minus the ones listed here, so an empty set means \"trunk everything\". " +
-								"Computed when not set, so the controller's current exclusions are preserved without producing a diff.",
-							Type:     schema.TypeSet,
-							Optional: true,
-							Computed: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"voice_networkconf_id": {
-							Description: "The ID of the network to use for Voice over IP (VoIP) traffic on this port, for automatic " +
-								"voice-VLAN assignment in conjunction with LLDP-MED.\n\n" +
-								"Computed when not set, so the controller's current value is preserved without producing a diff. " +
-								"Note: the underlying field uses `omitempty`, so once set it cannot be cleared back to empty " +
-								"through Terraform — change it to another network ID instead.",
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-						},
-						"setting_preference": {
-							Description: "Whether the port's settings are taken from a profile (`auto`) or set per-port (`manual`). " +
-								"Valid values are `auto` and `manual`. Per-port VLAN overrides (`native_networkconf_id`, " +
-								"`tagged_vlan_mgmt`, `forward`, `excluded_network_ids`) generally require `setting_preference = \"manual\"` " +
-								"to persist on the controller; with `auto` the controller may revert inline overrides to profile/auto " +
-								"behavior. Setting this to `manual` also overrides any `port_profile_id` on the same port. " +
-								"Computed when not set, so the value the controller attaches to the port is preserved without producing a diff.",
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringInSlice([]string{"auto", "manual"}, false),
-						},
-					},
+					Schema: portOverrideSchema(),
 				},
 			},
 
@@ -328,6 +203,48 @@ func ResourceDevice() *schema.Resource {
 							Optional:    true,
 							Computed:    true,
 						},
+						"wlan_override": {
+							Description: "Per-AP overrides of WLANs broadcast on this radio, e.g. a different SSID name or VLAN on this " +
+								"access point only. Only the declared overrides are managed: removing a block removes its override " +
+								"from the device, while overrides set in the UI for other WLANs are left untouched.",
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      wlanOverrideSetHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"wlan_id": {
+										Description: "ID of the `unifi_wlan` to override on this radio.",
+										Type:        schema.TypeString,
+										Required:    true,
+									},
+									"enabled": {
+										Description: "Whether the WLAN is broadcast on this radio. Set to `false` to stop broadcasting the SSID on this AP band. Defaults to `true`.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     true,
+									},
+									"name": {
+										Description:  "SSID name to broadcast on this radio instead of the WLAN's name.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 32),
+									},
+									"vlan_id": {
+										Description:  "VLAN to place clients of this WLAN on when connected through this radio, instead of the WLAN's network.",
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 4095),
+									},
+									"passphrase": {
+										Description:  "WPA pre-shared key to use on this radio instead of the WLAN's passphrase.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringLenBetween(8, 63),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -399,32 +316,206 @@ func ResourceDevice() *schema.Resource {
 	}
 }
 
+// portOverrideSchema returns the per-port override attributes shared by the
+// `port_override` block of unifi_device and the unifi_device_port resource.
+func portOverrideSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"number": {
+			Description: "The physical port number on the switch to configure.",
+			Type:        schema.TypeInt,
+			Required:    true,
+		},
+		"name": {
+			Description: "A friendly name for the port that will be displayed in the UniFi controller UI. Examples:\n" +
+				"  * 'Uplink to Core Switch'\n" +
+				"  * 'Conference Room AP'\n" +
+				"  * 'Server LACP Group 1'\n" +
+				"  * 'VoIP Phone Port'",
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"port_profile_id": {
+			Description: "The ID of a pre-configured port profile to apply to this port. Port profiles define settings like VLANs, PoE, and other port-specific configurations.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"op_mode": {
+			Description: "The operating mode of the port. Valid values are:\n" +
+				"  * `switch` - Normal switching mode (default)\n" +
+				"    - Standard port operation for connecting devices\n" +
+				"    - Supports VLANs and all standard switching features\n" +
+				"  * `mirror` - Port mirroring for traffic analysis\n" +
+				"    - Copies traffic from other ports for monitoring\n" +
+				"    - Useful for network troubleshooting and security\n" +
+				"  * `aggregate` - Link aggregation/bonding mode\n" +
+				"    - Combines multiple ports for increased bandwidth\n" +
+				"    - Used for switch uplinks or high-bandwidth servers",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "switch",
+			ValidateFunc: validation.StringInSlice([]string{"switch", "mirror", "aggregate"}, false),
+			DiffSuppressFunc: func(k, old, newValue string, d *schema.ResourceData) bool {
+				if old == "" && newValue == "switch" {
+					return true
+				}
+				return false
+			},
+		},
+		"poe_mode": {
+			Description: "The Power over Ethernet (PoE) mode for the port. Valid values are:\n" +
+				"* `auto` - Automatically detect and power PoE devices (recommended)\n" +
+				"  - Provides power based on device negotiation\n" +
+				"  - Safest option for most PoE devices\n" +
+				"* `pasv24` - Passive 24V PoE\n" +
+				"  - For older UniFi devices requiring passive 24V\n" +
+				"  - Use with caution to avoid damage\n" +
+				"* `passthrough` - PoE passthrough mode\n" +
+				"  - For daisy-chaining PoE devices\n" +
+				"  - Available on select UniFi switches\n" +
+				"* `off` - Disable PoE on the port\n" +
+				"  - For non-PoE devices\n" +
+				"  - To prevent unwanted power delivery",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"auto", "pasv24", "passthrough", "off"}, false),
+		},
+		"aggregate_num_ports": {
+			Description: "The number of ports to include in a link aggregation group (LAG). Valid range: 2-8 ports. Used when:\n" +
+				"* Creating switch-to-switch uplinks for increased bandwidth\n" +
+				"* Setting up high-availability connections\n" +
+				"* Connecting to servers requiring more bandwidth\n" +
+				"Note: All ports in the LAG must be sequential and have matching configurations. The LAG spans this port and the " +
+				"following ones, requires `op_mode = \"aggregate\"` and must fit within the device's ports. Member ports other than " +
+				"this one cannot be overridden separately.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(2, 8),
+			DiffSuppressFunc: func(k, old, newValue string, d *schema.ResourceData) bool {
+				if old == strconv.Itoa(0) && newValue == "" {
+					return true
+				}
+				return false
+			},
+		},
+		"mirror_port_idx": {
+			Description: "The port whose traffic is mirrored to this port. Required when `op_mode = \"mirror\"` and only valid " +
+				"in that mode. Must differ from `number` and fit within the device's ports.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"native_networkconf_id": {
+			Description: "The ID of the network to use as the native (untagged) network on this port. " +
+				"This is typically used for:\n" +
+				"* Access ports where devices need untagged access\n" +
+				"* Trunk ports to specify the native VLAN\n" +
+				"* Management networks for network devices\n\n" +
+				"Computed when not set, so the controller's current value (which it may auto-populate on a port) " +
+				"is preserved without producing a diff. Note: the underlying field uses `omitempty`, so once set it " +
+				"cannot be cleared back to empty through Terraform — change it to another network ID instead.",
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"tagged_vlan_mgmt": {
+			Description: "VLAN tagging behavior for the port. Valid values are:\n" +
+				"* `auto` - Automatically handle VLAN tags (recommended)\n" +
+				"* `block_all` - Block all VLAN tagged traffic\n" +
+				"* `custom` - Custom VLAN configuration (use with `forward = \"customize\"` and `excluded_network_ids`)\n\n" +
+				"Computed when not set, so the controller's current value is preserved without producing a diff. " +
+				"Note: the underlying field uses `omitempty`, so once set it cannot be cleared back to empty " +
+				"through Terraform — change it to another value instead.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"auto", "block_all", "custom"}, false),
+		},
+		"forward": {
+			Description: "VLAN forwarding mode for the port. Valid values are:\n" +
+				"  * `all` - Forward all VLANs (trunk port)\n" +
+				"  * `native` - Only forward untagged traffic (access port)\n" +
+				"  * `customize` - Forward selected VLANs (use with `excluded_network_ids`)\n" +
+				"  * `disabled` - Disable VLAN forwarding\n\n" +
+				"This attribute has NO default: leaving it unset keeps the port's existing forwarding behavior " +
+				"(the value is computed from the controller). Note: the underlying field uses `omitempty`, so once " +
+				"set it cannot be cleared back to empty through Terraform — change it to another value instead.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"all", "native", "customize", "disabled"}, false),
+		},
+		"excluded_network_ids": {
+			Description: "Set of network IDs to exclude when `forward = \"customize\"`. Tagged traffic on the port is " +
+				"*all* networks minus the ones listed here, so an empty set means \"trunk everything\". " +
+				"Computed when not set, so the controller's current exclusions are preserved without producing a diff.",
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"voice_networkconf_id": {
+			Description: "The ID of the network to use for Voice over IP (VoIP) traffic on this port, for automatic " +
+				"voice-VLAN assignment in conjunction with LLDP-MED.\n\n" +
+				"Computed when not set, so the controller's current value is preserved without producing a diff. " +
+				"Note: the underlying field uses `omitempty`, so once set it cannot be cleared back to empty " +
+				"through Terraform — change it to another network ID instead.",
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"setting_preference": {
+			Description: "Whether the port's settings are taken from a profile (`auto`) or set per-port (`manual`). " +
+				"Valid values are `auto` and `manual`. Per-port VLAN overrides (`native_networkconf_id`, " +
+				"`tagged_vlan_mgmt`, `forward`, `excluded_network_ids`) generally require `setting_preference = \"manual\"` " +
+				"to persist on the controller; with `auto` the controller may revert inline overrides to profile/auto " +
+				"behavior. Setting this to `manual` also overrides any `port_profile_id` on the same port. " +
+				"Computed when not set, so the value the controller attaches to the port is preserved without producing a diff.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"auto", "manual"}, false),
+		},
+	}
+}
+
 func resourceDeviceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	c, ok := meta.(*base.Client)
 	if !ok {
 		return nil, fmt.Errorf("unexpected meta type: %T", meta)
 	}
 	id := d.Id()
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return nil, err
 	}
 
 	if colons := strings.Count(id, ":"); colons == 1 || colons == 6 {
 		importParts := strings.SplitN(id, ":", 2)
-		site = importParts[0]
+		site, err = c.ResolveSiteName(importParts[0])
+		if err != nil {
+			return nil, err
+		}
 		id = importParts[1]
 	}
 
+	var device *unifi.Device
 	if utils.MacAddressRegexp.MatchString(id) {
 		// look up id by mac
 		mac := utils.CleanMAC(id)
-		device, err := c.GetDeviceByMAC(ctx, site, mac)
-		if err != nil {
-			return nil, err
-		}
+		device, err = c.GetDeviceByMAC(ctx, site, mac)
+	} else {
+		device, err = c.GetDevice(ctx, site, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	id = device.ID
 
-		id = device.ID
+	// No port is owned on import: the overrides on the device may belong to
+	// unifi_device_port resources, which this resource must leave alone. Ports
+	// declared in the configuration are taken over by the next apply.
+	if err := d.Set("port_override", []interface{}{}); err != nil {
+		return nil, err
 	}
 
 	if id != "" {
@@ -445,9 +536,9 @@ func resourceDeviceCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("unexpected meta type: %T", meta)
 	}
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	mac, _ := d.Get("mac").(string)
@@ -492,9 +583,15 @@ func resourceDeviceUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("unexpected meta type: %T", meta)
 	}
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("site") && !d.IsNewResource() {
+		if err := moveDevice(ctx, d, meta, site); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	req, err := resourceDeviceGetResourceData(d)
@@ -505,26 +602,60 @@ func resourceDeviceUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	req.ID = d.Id()
 	req.SiteID = site
 
-	// Radio table and Etherlighting are controller-side structures managed
-	// with patch semantics: fetch the device's current config once and overlay
-	// only the declared fields, so undeclared bands/fields keep their
-	// controller-side values. (Radio table additionally needs the full merged
-	// array sent because UniFi replaces arrays wholesale on PUT.) When neither
-	// block is declared, nothing extra is sent (prior behavior).
-	radios, _ := d.Get("radio").(*schema.Set)
-	etherLighting, _ := d.Get("ether_lighting").([]interface{})
-	if radios.Len() > 0 || len(etherLighting) > 0 {
-		current, err := c.GetDevice(ctx, site, d.Id())
+	// Port overrides may also be owned by unifi_device_port resources, so the
+	// read-modify-write of the device below is serialised per device.
+	unlock := lockDevice(site, req.MAC)
+	defer unlock()
+
+	current, err := c.GetDevice(ctx, site, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to read current device config for merge: %w", err))
+	}
+
+	// The controller replaces the `port_overrides` array wholesale on PUT, so
+	// the declared ports are merged into the current array. Ports this resource
+	// declared before (but no longer does) are dropped to reset them, and every
+	// other port keeps its controller-side override.
+	oldPorts, newPorts := d.GetChange("port_override")
+	oldPortSet, _ := oldPorts.(*schema.Set)
+	newPortSet, _ := newPorts.(*schema.Set)
+	req.PortOverrides = mergePortOverrides(current.PortOverrides, portOverrideNumbers(oldPortSet, newPortSet), req.PortOverrides)
+	if newPortSet.Len() > 0 {
+		portCount, err := devicePortCount(ctx, c, site, current.MAC)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("unable to read current device config for merge: %w", err))
+			return diag.FromErr(fmt.Errorf("unable to read port count of device: %w", err))
 		}
-		if radios.Len() > 0 {
-			req.RadioTable = mergeRadios(current.RadioTable, radios)
+		if err := validatePortOverrideLayout(req.PortOverrides, portCount); err != nil {
+			return diag.FromErr(err)
 		}
-		if len(etherLighting) > 0 {
-			etherLightingMap, _ := etherLighting[0].(map[string]interface{})
-			req.EtherLighting = mergeEtherLighting(current.EtherLighting, etherLightingMap)
+	}
+
+	// Radio table and Etherlighting are controller-side structures managed
+	// with patch semantics: overlay only the declared fields on the device's
+	// current config, so undeclared bands/fields keep their controller-side
+	// values. (Radio table additionally needs the full merged array sent
+	// because UniFi replaces arrays wholesale on PUT.) When neither block is
+	// declared, nothing extra is sent.
+	// WLAN overrides follow the port override ownership: only the overrides
+	// this resource declares, or declared before, are replaced, so overrides
+	// set in the UI survive even on bands declared without `wlan_override`.
+	oldRadios, newRadios := d.GetChange("radio")
+	oldRadioSet, _ := oldRadios.(*schema.Set)
+	radios, _ := newRadios.(*schema.Set)
+	if radios.Len() > 0 {
+		req.RadioTable = mergeRadios(current.RadioTable, radios)
+	}
+	if owned := wlanOverrideKeys(oldRadioSet, radios); len(owned) > 0 {
+		radioTable := req.RadioTable
+		if len(radioTable) == 0 {
+			radioTable = current.RadioTable
 		}
+		req.WLANOverrides = mergeWLANOverrides(radioTable, current.WLANOverrides, owned, radios)
+	}
+	etherLighting, _ := d.Get("ether_lighting").([]interface{})
+	if len(etherLighting) > 0 {
+		etherLightingMap, _ := etherLighting[0].(map[string]interface{})
+		req.EtherLighting = mergeEtherLighting(current.EtherLighting, etherLightingMap)
 	}
 
 	// go-unifi v1.9.2's updateDevice converts a successful-but-empty PUT response into
@@ -550,6 +681,64 @@ func resourceDeviceUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	return resourceDeviceSetResourceData(resp, d, site)
 }
 
+// moveDevice moves the device from the site in state to the target site with
+// the controller's move-device command, keeping it adopted, and waits for the
+// device to connect in the target site.
+func moveDevice(ctx context.Context, d *schema.ResourceData, meta interface{}, target string) error {
+	c, ok := meta.(*base.Client)
+	if !ok {
+		return fmt.Errorf("unexpected meta type: %T", meta)
+	}
+
+	oldSite, _ := d.GetChange("site")
+	source, _ := oldSite.(string)
+	if source == "" {
+		source = c.Site
+	}
+	source, err := c.ResolveSiteName(source)
+	if err != nil {
+		return err
+	}
+	if source == target {
+		return nil
+	}
+
+	sites, err := c.ListSites(ctx)
+	if err != nil {
+		return err
+	}
+	var targetID string
+	for _, s := range sites {
+		if s.Name == target {
+			targetID = s.ID
+			break
+		}
+	}
+	if targetID == "" {
+		return fmt.Errorf("unable to find site %q on controller", target)
+	}
+
+	mac, _ := d.Get("mac").(string)
+	mac = utils.CleanMAC(mac)
+	var resp base.RestResponse[struct{}]
+	err = c.Do(ctx, http.MethodPost, fmt.Sprintf("s/%s/cmd/sitemgr", source), map[string]string{
+		"cmd":  "move-device",
+		"mac":  mac,
+		"site": targetID,
+	}, &resp)
+	if err != nil {
+		return fmt.Errorf("unable to move device %q from site %q to site %q: %w", mac, source, target, err)
+	}
+
+	device, err := waitForDeviceState(ctx, d, meta, unifi.DeviceStateConnected, []unifi.DeviceState{unifi.DeviceStateAdopting, unifi.DeviceStateProvisioning}, 2*time.Minute)
+	if err != nil {
+		return fmt.Errorf("device %q did not connect in site %q after moving: %w", mac, target, err)
+	}
+	// The device is re-read in the target site, which may know it by another ID.
+	d.SetId(device.ID)
+	return nil
+}
+
 func resourceDeviceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c, ok := meta.(*base.Client)
 	if !ok {
@@ -560,13 +749,12 @@ func resourceDeviceDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return nil
 	}
 
-	site, _ := d.Get("site").(string)
 	mac, _ := d.Get("mac").(string)
-
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+	err = retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
 		internalErr := c.ForgetDevice(ctx, site, mac)
 		if internalErr == nil {
 			return nil
@@ -596,9 +784,9 @@ func resourceDeviceRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.GetDevice(ctx, site, id)
@@ -614,7 +802,10 @@ func resourceDeviceRead(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func resourceDeviceSetResourceData(resp *unifi.Device, d *schema.ResourceData, site string) diag.Diagnostics {
-	portOverrides := setFromPortOverrides(resp.PortOverrides)
+	// Only surface the ports this resource manages; overrides owned by
+	// unifi_device_port resources (or set outside Terraform) are not state.
+	managedPorts, _ := d.Get("port_override").(*schema.Set)
+	portOverrides := setFromPortOverrides(filterPortOverrides(resp.PortOverrides, portOverrideNumbers(managedPorts)))
 
 	values := map[string]interface{}{
 		"site":                site,
@@ -622,6 +813,7 @@ func resourceDeviceSetResourceData(resp *unifi.Device, d *schema.ResourceData, s
 		"name":                resp.Name,
 		"disabled":            resp.Disabled,
 		"switch_vlan_enabled": resp.SwitchVLANEnabled,
+		"band_steering_mode":  resp.BandsteeringMode,
 		"port_override":       portOverrides,
 		"radio":               radiosFromDevice(resp, d),
 		"ether_lighting":      etherLightingFromDevice(resp, d),
@@ -693,7 +885,8 @@ func portOverrideSetHash(v interface{}) int {
 
 // radiosFromDevice returns radio state for only the bands the user manages
 // (present in config/state), so undeclared bands on the device never produce
-// a diff.
+// a diff. Likewise only the WLAN overrides in state are reported, leaving
+// overrides set in the UI out of the plan.
 func radiosFromDevice(resp *unifi.Device, d *schema.ResourceData) []map[string]interface{} {
 	managed := map[string]bool{}
 	radioSet, _ := d.Get("radio").(*schema.Set)
@@ -702,15 +895,130 @@ func radiosFromDevice(resp *unifi.Device, d *schema.ResourceData) []map[string]i
 		name, _ := m["name"].(string)
 		managed[name] = true
 	}
+	owned := wlanOverrideKeys(radioSet)
 	radios := make([]map[string]interface{}, 0, len(managed))
 	for _, r := range resp.RadioTable {
 		if managed[r.Radio] {
-			radios = append(radios, fromRadio(r))
+			radio := fromRadio(r)
+			radio["wlan_override"] = wlanOverridesFromDevice(resp.WLANOverrides, r.Radio, owned)
+			radios = append(radios, radio)
 		}
 	}
 	return radios
 }
 
+// wlanOverrideSetHash keys the `wlan_override` set by WLAN ID only, as a WLAN
+// can be overridden at most once per radio.
+func wlanOverrideSetHash(v interface{}) int {
+	m, _ := v.(map[string]interface{})
+	wlanID, _ := m["wlan_id"].(string)
+	return schema.HashString(wlanID)
+}
+
+// wlanOverrideKey identifies a WLAN override by its band and WLAN.
+type wlanOverrideKey struct {
+	radio  string
+	wlanID string
+}
+
+// wlanOverrideKeys returns the WLAN overrides declared across the given
+// `radio` sets.
+func wlanOverrideKeys(sets ...*schema.Set) map[wlanOverrideKey]bool {
+	keys := map[wlanOverrideKey]bool{}
+	for _, set := range sets {
+		if set == nil {
+			continue
+		}
+		for _, item := range set.List() {
+			m, _ := item.(map[string]interface{})
+			band, _ := m["name"].(string)
+			overrideSet, _ := m["wlan_override"].(*schema.Set)
+			if overrideSet == nil {
+				continue
+			}
+			for _, o := range overrideSet.List() {
+				data, _ := o.(map[string]interface{})
+				wlanID, _ := data["wlan_id"].(string)
+				keys[wlanOverrideKey{radio: band, wlanID: wlanID}] = true
+			}
+		}
+	}
+	return keys
+}
+
+// wlanOverridesFromDevice returns the device's WLAN overrides of a single band
+// that are in owned.
+func wlanOverridesFromDevice(overrides []unifi.DeviceWLANOverrides, band string, owned map[wlanOverrideKey]bool) []interface{} {
+	list := make([]interface{}, 0)
+	for _, o := range overrides {
+		if o.Radio != band || !owned[wlanOverrideKey{radio: o.Radio, wlanID: o.WLANID}] {
+			continue
+		}
+		vlan := 0
+		if o.VLANEnabled {
+			vlan = o.VLAN
+		}
+		list = append(list, map[string]interface{}{
+			"wlan_id":    o.WLANID,
+			"enabled":    o.Enabled,
+			"name":       o.Name,
+			"vlan_id":    vlan,
+			"passphrase": o.XPassphrase,
+		})
+	}
+	return list
+}
+
+// mergeWLANOverrides complements mergeRadios for the device's wlan_overrides
+// array, which the controller keeps next to (not inside) the radio table. Like
+// mergePortOverrides, it keeps the current overrides not in owned and appends
+// the declared ones, so owned overrides that are no longer declared are
+// removed while those set in the UI are preserved. radios is the merged radio
+// table, used to resolve each band's radio interface name.
+func mergeWLANOverrides(radios []unifi.DeviceRadioTable, current []unifi.DeviceWLANOverrides, owned map[wlanOverrideKey]bool, set *schema.Set) []unifi.DeviceWLANOverrides {
+	radioNames := map[string]string{}
+	for _, r := range radios {
+		radioNames[r.Radio] = r.Name
+	}
+	declared := map[string][]unifi.DeviceWLANOverrides{}
+	for _, item := range set.List() {
+		m, _ := item.(map[string]interface{})
+		band, _ := m["name"].(string)
+		overrideSet, _ := m["wlan_override"].(*schema.Set)
+		if overrideSet == nil {
+			continue
+		}
+		for _, o := range overrideSet.List() {
+			data, _ := o.(map[string]interface{})
+			wlanID, _ := data["wlan_id"].(string)
+			enabled, _ := data["enabled"].(bool)
+			name, _ := data["name"].(string)
+			vlan, _ := data["vlan_id"].(int)
+			passphrase, _ := data["passphrase"].(string)
+			declared[band] = append(declared[band], unifi.DeviceWLANOverrides{
+				Radio:       band,
+				RadioName:   radioNames[band],
+				WLANID:      wlanID,
+				Enabled:     enabled,
+				Name:        name,
+				VLAN:        vlan,
+				VLANEnabled: vlan != 0,
+				XPassphrase: passphrase,
+			})
+		}
+	}
+	out := make([]unifi.DeviceWLANOverrides, 0, len(current))
+	for _, o := range current {
+		if !owned[wlanOverrideKey{radio: o.Radio, wlanID: o.WLANID}] {
+			out = append(out, o)
+		}
+	}
+	for _, r := range radios {
+		out = append(out, declared[r.Radio]...)
+	}
+	return out
+}
+
 func fromRadio(r unifi.DeviceRadioTable) map[string]interface{} {
 	return map[string]interface{}{
 		"name":             r.Radio,
@@ -780,11 +1088,13 @@ func resourceDeviceGetResourceData(d *schema.ResourceData) (*unifi.Device, error
 	mac, _ := d.Get("mac").(string)
 	name, _ := d.Get("name").(string)
 	switchVLANEnabled, _ := d.Get("switch_vlan_enabled").(bool)
+	bandSteeringMode, _ := d.Get("band_steering_mode").(string)
 
 	return &unifi.Device{
 		MAC:               mac,
 		Name:              name,
 		SwitchVLANEnabled: switchVLANEnabled,
+		BandsteeringMode:  bandSteeringMode,
 		PortOverrides:     pos,
 	}, nil
 }
@@ -819,6 +1129,52 @@ func setFromPortOverrides(pos []unifi.DevicePortOverrides) []map[string]interfac
 	return list
 }
 
+// portOverrideNumbers returns the port numbers declared across the given
+// `port_override` sets.
+func portOverrideNumbers(sets ...*schema.Set) map[int]bool {
+	numbers := map[int]bool{}
+	for _, set := range sets {
+		if set == nil {
+			continue
+		}
+		for _, item := range set.List() {
+			data, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if number, ok := data["number"].(int); ok {
+				numbers[number] = true
+			}
+		}
+	}
+	return numbers
+}
+
+// mergePortOverrides keeps the current overrides of ports not in owned and
+// appends the declared ones, so a PUT of the result leaves ports managed
+// elsewhere untouched. Owned ports that are no longer declared are dropped,
+// which resets them to the controller default.
+func mergePortOverrides(current []unifi.DevicePortOverrides, owned map[int]bool, declared []unifi.DevicePortOverrides) []unifi.DevicePortOverrides {
+	merged := make([]unifi.DevicePortOverrides, 0, len(current)+len(declared))
+	for _, po := range current {
+		if !owned[po.PortIDX] {
+			merged = append(merged, po)
+		}
+	}
+	return append(merged, declared...)
+}
+
+// filterPortOverrides returns the overrides of the given port numbers.
+func filterPortOverrides(pos []unifi.DevicePortOverrides, numbers map[int]bool) []unifi.DevicePortOverrides {
+	filtered := make([]unifi.DevicePortOverrides, 0, len(numbers))
+	for _, po := range pos {
+		if numbers[po.PortIDX] {
+			filtered = append(filtered, po)
+		}
+	}
+	return filtered
+}
+
 func toPortOverride(data map[string]interface{}) (unifi.DevicePortOverrides, error) {
 	idx, _ := data["number"].(int)
 	name, _ := data["name"].(string)
@@ -826,6 +1182,7 @@ func toPortOverride(data map[string]interface{}) (unifi.DevicePortOverrides, err
 	opMode, _ := data["op_mode"].(string)
 	poeMode, _ := data["poe_mode"].(string)
 	aggregateNumPorts, _ := data["aggregate_num_ports"].(int)
+	mirrorPortIDX, _ := data["mirror_port_idx"].(int)
 
 	var excludedNetworkIDs []string
 	if set, ok := data["excluded_network_ids"].(*schema.Set); ok {
@@ -837,10 +1194,10 @@ func toPortOverride(data map[string]interface{}) (unifi.DevicePortOverrides, err
 	}
 
 	// Per-port VLAN overrides. All of these are `omitempty` on the controller
-	// side, so an unset (empty) value is dropped from the PUT. The user declares
-	// the whole set of ports, and the device PUT replaces the `port_overrides`
-	// array wholesale (no read-modify-write merge). comma-ok reads tolerate a
-	// partially-populated data map (e.g. in unit tests).
+	// side, so an unset (empty) value is dropped from the PUT. The override
+	// replaces the port's whole entry in the device's `port_overrides` array
+	// (see mergePortOverrides). comma-ok reads tolerate a partially-populated
+	// data map (e.g. in unit tests).
 	nativeNetworkID, _ := data["native_networkconf_id"].(string)
 	taggedVLANMgmt, _ := data["tagged_vlan_mgmt"].(string)
 	forward, _ := data["forward"].(string)
@@ -859,6 +1216,7 @@ func toPortOverride(data map[string]interface{}) (unifi.DevicePortOverrides, err
 		ExcludedNetworkIDs: excludedNetworkIDs,
 		VoiceNetworkID:     voiceNetworkID,
 		SettingPreference:  settingPreference,
+		MirrorPortIDX:      mirrorPortIDX,
 	}
 
 	// go-unifi v1.9 tracks the current controller API, which expresses a LAG
@@ -877,6 +1235,9 @@ func toPortOverride(data map[string]interface{}) (unifi.DevicePortOverrides, err
 		po.AggregateMembers = members
 	}
 
+	if err := validatePortOverrideMode(po); err != nil {
+		return unifi.DevicePortOverrides{}, err
+	}
 	return po, nil
 }
 
@@ -891,6 +1252,7 @@ func fromPortOverride(po unifi.DevicePortOverrides) map[string]interface{} {
 		// length is the LAG port count (0 / unset round-trips as an empty
 		// list, preserving the previous zero-value behavior).
 		"aggregate_num_ports": len(po.AggregateMembers),
+		"mirror_port_idx":     po.MirrorPortIDX,
 		// Per-port VLAN overrides, round-tripped unconditionally to match the
 		// existing fields above (keeps ImportStateVerify consistent). These
 		// attributes are Optional+Computed and the set is keyed by port number
@@ -912,11 +1274,10 @@ func waitForDeviceState(ctx context.Context, d *schema.ResourceData, meta interf
 		return nil, fmt.Errorf("unexpected meta type: %T", meta)
 	}
 
-	site, _ := d.Get("site").(string)
 	mac, _ := d.Get("mac").(string)
-
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return nil, err
 	}
 
 	// Always consider unknown to be a pending state.
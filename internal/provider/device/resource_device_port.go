@@ -0,0 +1,302 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
+)
+
+func ResourceDevicePort() *schema.Resource {
+	s := portOverrideSchema()
+	s["number"].ForceNew = true
+	s["id"] = &schema.Schema{
+		Description: "The identifier of the port override, in the form `<device_mac>/<number>`.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["site"] = &schema.Schema{
+		Description: "The name of the UniFi site where the device is located. If not specified, the default site will be used. " +
+			"Changing it follows a move of the device by a `unifi_device` resource: the override is written in the new site " +
+			"instead of being destroyed and recreated. Set it from the `site` of the `unifi_device` resource, so the port is " +
+			"updated after the device has moved.",
+		Type:             schema.TypeString,
+		Computed:         true,
+		Optional:         true,
+		DiffSuppressFunc: utils.SiteDiffSuppressFunc,
+	}
+	s["device_mac"] = &schema.Schema{
+		Description:      "The MAC address of the switch or gateway owning the port, in standard format (e.g., 'aa:bb:cc:dd:ee:ff').",
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: utils.MacDiffSuppressFunc,
+		ValidateFunc:     validation.StringMatch(utils.MacAddressRegexp, "Mac address is invalid"),
+	}
+
+	return &schema.Resource{
+		Description: "The `unifi_device_port` resource manages the override of a single port of an adopted UniFi device.\n\n" +
+			"The controller stores all port overrides as one array on the device. This resource owns only the entry of its " +
+			"port: it reads the device's current overrides, replaces its own entry and writes the array back, so several " +
+			"`unifi_device_port` resources (possibly in different Terraform configurations) can manage ports of the same " +
+			"switch. Writes to the same device are serialised within a Terraform run.\n\n" +
+			"Do not declare the same port both here and in the `port_override` block of a `unifi_device` resource. " +
+			"Destroying the resource resets the port to the controller default.",
+
+		CreateContext: resourceDevicePortUpsert,
+		ReadContext:   resourceDevicePortRead,
+		UpdateContext: resourceDevicePortUpsert,
+		DeleteContext: resourceDevicePortDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDevicePortImport,
+		},
+		CustomizeDiff: customdiff.All(
+			customizeDevicePort,
+			customizeDevicePortModel,
+		),
+
+		Schema: s,
+	}
+}
+
+// devicePortID returns the resource ID of the given port of the device.
+func devicePortID(mac string, number int) string {
+	return fmt.Sprintf("%s/%d", utils.CleanMAC(mac), number)
+}
+
+// parseDevicePortID splits an import ID of the form `[site/]mac/number`.
+func parseDevicePortID(id string) (site, mac string, number int, err error) {
+	var numberPart string
+	parts := strings.Split(id, "/")
+	switch len(parts) {
+	case 2:
+		mac, numberPart = parts[0], parts[1]
+	case 3:
+		site, mac, numberPart = parts[0], parts[1], parts[2]
+	default:
+		return "", "", 0, fmt.Errorf("invalid import ID %q, expected <device_mac>/<number> or <site>/<device_mac>/<number>", id)
+	}
+	if !utils.MacAddressRegexp.MatchString(mac) {
+		return "", "", 0, fmt.Errorf("invalid device MAC address %q in import ID", mac)
+	}
+	number, err = strconv.Atoi(numberPart)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid port number in import ID %q: %w", id, err)
+	}
+	return site, utils.CleanMAC(mac), number, nil
+}
+
+func resourceDevicePortImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	site, mac, number, err := parseDevicePortID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]interface{}{
+		"device_mac": mac,
+		"number":     number,
+	}
+	if site != "" {
+		values["site"] = site
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return nil, err
+		}
+	}
+	d.SetId(devicePortID(mac, number))
+	return []*schema.ResourceData{d}, nil
+}
+
+// replacePortOverride returns the overrides with the entry of the given port
+// replaced by po, or removed when po is nil.
+func replacePortOverride(current []unifi.DevicePortOverrides, number int, po *unifi.DevicePortOverrides) []unifi.DevicePortOverrides {
+	declared := []unifi.DevicePortOverrides{}
+	if po != nil {
+		declared = append(declared, *po)
+	}
+	return mergePortOverrides(current, map[int]bool{number: true}, declared)
+}
+
+// findPortOverride returns the override of the given port, if any.
+func findPortOverride(pos []unifi.DevicePortOverrides, number int) (unifi.DevicePortOverrides, bool) {
+	for _, po := range pos {
+		if po.PortIDX == number {
+			return po, true
+		}
+	}
+	return unifi.DevicePortOverrides{}, false
+}
+
+// updateDevicePortOverrides writes the port overrides of the device back to the
+// controller. Only the fields identifying the device are sent alongside, so the
+// rest of its configuration is left as is.
+func updateDevicePortOverrides(ctx context.Context, c *base.Client, site string, device *unifi.Device, pos []unifi.DevicePortOverrides) (*unifi.Device, bool, error) {
+	req := &unifi.Device{
+		ID:                device.ID,
+		SiteID:            site,
+		MAC:               device.MAC,
+		Name:              device.Name,
+		SwitchVLANEnabled: device.SwitchVLANEnabled,
+		PortOverrides:     pos,
+	}
+	// See resourceDeviceUpdate: an empty PUT response surfaces as
+	// unifi.ErrNotFound, so re-read to tell it from a genuine deletion.
+	resp, err := c.UpdateDevice(ctx, site, req)
+	return utils.ReReadOnUpdateNotFound(resp, err, func() (*unifi.Device, error) {
+		return c.GetDevice(ctx, site, req.ID)
+	})
+}
+
+func resourceDevicePortUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ok := meta.(*base.Client)
+	if !ok {
+		return diag.Errorf("unexpected meta type: %T", meta)
+	}
+
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	mac, _ := d.Get("device_mac").(string)
+	mac = utils.CleanMAC(mac)
+
+	po, err := toPortOverride(map[string]interface{}{
+		"number":                d.Get("number"),
+		"name":                  d.Get("name"),
+		"port_profile_id":       d.Get("port_profile_id"),
+		"op_mode":               d.Get("op_mode"),
+		"poe_mode":              d.Get("poe_mode"),
+		"aggregate_num_ports":   d.Get("aggregate_num_ports"),
+		"native_networkconf_id": d.Get("native_networkconf_id"),
+		"tagged_vlan_mgmt":      d.Get("tagged_vlan_mgmt"),
+		"forward":               d.Get("forward"),
+		"excluded_network_ids":  d.Get("excluded_network_ids"),
+		"voice_networkconf_id":  d.Get("voice_networkconf_id"),
+		"setting_preference":    d.Get("setting_preference"),
+		"mirror_port_idx":       d.Get("mirror_port_idx"),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	unlock := lockDevice(site, mac)
+	defer unlock()
+
+	device, err := c.GetDeviceByMAC(ctx, site, mac)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to read device %q: %w", mac, err))
+	}
+
+	pos := replacePortOverride(device.PortOverrides, po.PortIDX, &po)
+	portCount, err := devicePortCount(ctx, c, site, mac)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to read port count of device %q: %w", mac, err))
+	}
+	if err := validatePortOverrideLayout(pos, portCount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, found, err := updateDevicePortOverrides(ctx, c, site, device, pos)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		return diag.Errorf("device %q not found after writing the override of port %d", mac, po.PortIDX)
+	}
+
+	d.SetId(devicePortID(mac, po.PortIDX))
+	return resourceDevicePortSetResourceData(resp, d, site, po.PortIDX)
+}
+
+func resourceDevicePortRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ok := meta.(*base.Client)
+	if !ok {
+		return diag.Errorf("unexpected meta type: %T", meta)
+	}
+
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	mac, _ := d.Get("device_mac").(string)
+	number, _ := d.Get("number").(int)
+
+	device, err := c.GetDeviceByMAC(ctx, site, utils.CleanMAC(mac))
+	if errors.Is(err, unifi.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, found := findPortOverride(device.PortOverrides, number); !found {
+		d.SetId("")
+		return nil
+	}
+
+	return resourceDevicePortSetResourceData(device, d, site, number)
+}
+
+// resourceDevicePortSetResourceData sets the state from the override of the
+// port on the device. A missing override is an error, as it is only called
+// once the override is known to exist: after Read found it, or after a write
+// that must have created it.
+func resourceDevicePortSetResourceData(device *unifi.Device, d *schema.ResourceData, site string, number int) diag.Diagnostics {
+	po, found := findPortOverride(device.PortOverrides, number)
+	if !found {
+		return diag.Errorf("override of port %d not found on device %q", number, device.MAC)
+	}
+
+	values := fromPortOverride(po)
+	values["site"] = site
+	values["device_mac"] = device.MAC
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceDevicePortDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, ok := meta.(*base.Client)
+	if !ok {
+		return diag.Errorf("unexpected meta type: %T", meta)
+	}
+
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	mac, _ := d.Get("device_mac").(string)
+	mac = utils.CleanMAC(mac)
+	number, _ := d.Get("number").(int)
+
+	unlock := lockDevice(site, mac)
+	defer unlock()
+
+	device, err := c.GetDeviceByMAC(ctx, site, mac)
+	if errors.Is(err, unifi.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, found := findPortOverride(device.PortOverrides, number); !found {
+		return nil
+	}
+
+	_, _, err = updateDevicePortOverrides(ctx, c, site, device, replacePortOverride(device.PortOverrides, number, nil))
+	return diag.FromErr(err)
+}
@@ -0,0 +1,149 @@
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+)
+
+// fakeDevicePortClient keeps a single device in memory and records the port
+// overrides of every PUT.
+type fakeDevicePortClient struct {
+	unifi.Client
+
+	device    unifi.Device
+	portCount int
+	updates   int
+	// dropOverrides makes the controller ignore the port overrides of PUTs.
+	dropOverrides bool
+}
+
+func (f *fakeDevicePortClient) GetDeviceByMAC(_ context.Context, _, _ string) (*unifi.Device, error) {
+	d := f.device
+	return &d, nil
+}
+
+func (f *fakeDevicePortClient) GetDevice(_ context.Context, _, _ string) (*unifi.Device, error) {
+	d := f.device
+	return &d, nil
+}
+
+func (f *fakeDevicePortClient) Do(_ context.Context, _, _ string, _ interface{}, respBody interface{}) error {
+	return json.Unmarshal([]byte(fmt.Sprintf(`{"data":[{"port_table":[{"port_idx":%d}]}]}`, f.portCount)), respBody)
+}
+
+func (f *fakeDevicePortClient) UpdateDevice(_ context.Context, _ string, d *unifi.Device) (*unifi.Device, error) {
+	f.updates++
+	if !f.dropOverrides {
+		f.device.PortOverrides = d.PortOverrides
+	}
+	resp := f.device
+	return &resp, nil
+}
+
+func TestParseDevicePortID(t *testing.T) {
+	site, mac, number, err := parseDevicePortID("AA:BB:CC:DD:EE:FF/7")
+	require.NoError(t, err)
+	assert.Empty(t, site)
+	assert.Equal(t, "aa:bb:cc:dd:ee:ff", mac)
+	assert.Equal(t, 7, number)
+
+	site, _, _, err = parseDevicePortID("branch/aa:bb:cc:dd:ee:ff/7")
+	require.NoError(t, err)
+	assert.Equal(t, "branch", site)
+
+	for _, id := range []string{"aa:bb:cc:dd:ee:ff", "not-a-mac/1", "aa:bb:cc:dd:ee:ff/x", "a/b/c/d"} {
+		_, _, _, err = parseDevicePortID(id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestResourceDevicePort_OwnsOnlyItsEntry(t *testing.T) {
+	fake := &fakeDevicePortClient{device: unifi.Device{
+		ID:  "dev1",
+		MAC: "aa:bb:cc:dd:ee:ff",
+		PortOverrides: []unifi.DevicePortOverrides{
+			{PortIDX: 1, Name: "owned elsewhere"},
+			{PortIDX: 2, Name: "stale"},
+		},
+	}, portCount: 8}
+	client := &base.Client{Client: fake, Site: "default"}
+
+	d := schema.TestResourceDataRaw(t, ResourceDevicePort().Schema, map[string]interface{}{
+		"device_mac": "aa:bb:cc:dd:ee:ff",
+		"number":     2,
+		"name":       "Printer",
+		"poe_mode":   "off",
+	})
+	diags := resourceDevicePortUpsert(context.Background(), d, client)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "aa:bb:cc:dd:ee:ff/2", d.Id())
+	assert.Equal(t, "Printer", d.Get("name"))
+
+	assert.Len(t, fake.device.PortOverrides, 2)
+	po, found := findPortOverride(fake.device.PortOverrides, 1)
+	require.True(t, found)
+	assert.Equal(t, "owned elsewhere", po.Name)
+	po, found = findPortOverride(fake.device.PortOverrides, 2)
+	require.True(t, found)
+	assert.Equal(t, "Printer", po.Name)
+
+	diags = resourceDevicePortDelete(context.Background(), d, client)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, []unifi.DevicePortOverrides{{PortIDX: 1, Name: "owned elsewhere"}}, fake.device.PortOverrides)
+
+	// Deleting again is a no-op once the entry is gone.
+	diags = resourceDevicePortDelete(context.Background(), d, client)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, 2, fake.updates)
+}
+
+func TestResourceDevicePort_RejectsLagMemberOverride(t *testing.T) {
+	fake := &fakeDevicePortClient{device: unifi.Device{
+		ID:  "dev1",
+		MAC: "aa:bb:cc:dd:ee:ff",
+		PortOverrides: []unifi.DevicePortOverrides{
+			{PortIDX: 1, OpMode: "aggregate", AggregateMembers: []int{1, 2}},
+		},
+	}, portCount: 8}
+	client := &base.Client{Client: fake, Site: "default"}
+
+	d := schema.TestResourceDataRaw(t, ResourceDevicePort().Schema, map[string]interface{}{
+		"device_mac": "aa:bb:cc:dd:ee:ff",
+		"number":     2,
+		"name":       "LAG member",
+	})
+	diags := resourceDevicePortUpsert(context.Background(), d, client)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "member of the LAG on port 1")
+	assert.Zero(t, fake.updates)
+}
+
+// A write whose override is missing afterwards fails, while Read treats a
+// missing override as removed out of band.
+func TestResourceDevicePort_OverrideMissing(t *testing.T) {
+	fake := &fakeDevicePortClient{device: unifi.Device{ID: "dev1", MAC: "aa:bb:cc:dd:ee:ff"}, portCount: 8, dropOverrides: true}
+	client := &base.Client{Client: fake, Site: "default"}
+
+	d := schema.TestResourceDataRaw(t, ResourceDevicePort().Schema, map[string]interface{}{
+		"device_mac": "aa:bb:cc:dd:ee:ff",
+		"number":     2,
+		"name":       "Printer",
+	})
+	diags := resourceDevicePortUpsert(context.Background(), d, client)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "override of port 2 not found")
+
+	d.SetId("aa:bb:cc:dd:ee:ff/2")
+	diags = resourceDevicePortRead(context.Background(), d, client)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Empty(t, d.Id())
+}
@@ -1,12 +1,15 @@
 package device
 
 import (
+	"context"
 	"testing"
 
 	"github.com/filipowm/go-unifi/unifi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
 )
 
 func TestToPortOverrideAggregateTranslation(t *testing.T) {
@@ -362,3 +365,158 @@ func TestMergeEtherLighting_FromEmptyCurrent(t *testing.T) {
 		t.Errorf("unexpected merge from empty: %+v", got)
 	}
 }
+
+func wlanOverrideSet(items ...map[string]interface{}) *schema.Set {
+	raw := make([]interface{}, len(items))
+	for i, m := range items {
+		raw[i] = m
+	}
+	return schema.NewSet(wlanOverrideSetHash, raw)
+}
+
+// Declared overrides replace the ones this resource owned before, while
+// overrides of other WLANs and bands are kept.
+func TestMergeWLANOverrides_ReplacesOwnedOnly(t *testing.T) {
+	radios := []unifi.DeviceRadioTable{{Radio: "ng", Name: "wifi0"}, {Radio: "na", Name: "wifi1"}}
+	current := []unifi.DeviceWLANOverrides{
+		{Radio: "ng", RadioName: "wifi0", WLANID: "wlan1", Name: "old-ng", Enabled: true},
+		{Radio: "ng", RadioName: "wifi0", WLANID: "wlan3", Name: "ui-ng", Enabled: true},
+		{Radio: "na", RadioName: "wifi1", WLANID: "wlan1", Name: "keep-na", Enabled: true},
+	}
+	old := radioSet(map[string]interface{}{
+		"name": "ng",
+		"wlan_override": wlanOverrideSet(map[string]interface{}{
+			"wlan_id": "wlan1", "enabled": true, "name": "old-ng", "vlan_id": 0, "passphrase": "",
+		}),
+	})
+	declared := radioSet(map[string]interface{}{
+		"name": "ng",
+		"wlan_override": wlanOverrideSet(map[string]interface{}{
+			"wlan_id": "wlan2", "enabled": true, "name": "new-ng", "vlan_id": 20, "passphrase": "",
+		}),
+	})
+	got := mergeWLANOverrides(radios, current, wlanOverrideKeys(old, declared), declared)
+	require.Len(t, got, 3)
+	assert.Contains(t, got, current[1])
+	assert.Contains(t, got, current[2])
+	assert.Contains(t, got, unifi.DeviceWLANOverrides{
+		Radio: "ng", RadioName: "wifi0", WLANID: "wlan2", Name: "new-ng", Enabled: true, VLAN: 20, VLANEnabled: true,
+	})
+}
+
+// Removing the last wlan_override block of a band removes its override.
+func TestMergeWLANOverrides_RemovesUndeclaredOwned(t *testing.T) {
+	radios := []unifi.DeviceRadioTable{{Radio: "ng", Name: "wifi0"}, {Radio: "na", Name: "wifi1"}}
+	current := []unifi.DeviceWLANOverrides{
+		{Radio: "ng", RadioName: "wifi0", WLANID: "wlan1", Name: "removed"},
+		{Radio: "na", RadioName: "wifi1", WLANID: "wlan1", Name: "ui-managed"},
+	}
+	old := radioSet(map[string]interface{}{
+		"name": "ng",
+		"wlan_override": wlanOverrideSet(map[string]interface{}{
+			"wlan_id": "wlan1", "enabled": false, "name": "removed", "vlan_id": 0, "passphrase": "",
+		}),
+	})
+	declared := radioSet(map[string]interface{}{"name": "ng", "tx_power_mode": "low"})
+	got := mergeWLANOverrides(radios, current, wlanOverrideKeys(old, declared), declared)
+	assert.Equal(t, current[1:], got)
+}
+
+// A band declared before wlan_override existed, i.e. without blocks in state
+// or configuration, keeps the overrides set in the UI after upgrading.
+func TestMergeWLANOverrides_UpgradeKeepsUIOverrides(t *testing.T) {
+	radios := []unifi.DeviceRadioTable{{Radio: "ng", Name: "wifi0"}}
+	current := []unifi.DeviceWLANOverrides{{Radio: "ng", RadioName: "wifi0", WLANID: "wlan1", Name: "ui-managed"}}
+	declared := radioSet(map[string]interface{}{"name": "ng", "tx_power_mode": "low"})
+	owned := wlanOverrideKeys(radioSet(map[string]interface{}{"name": "ng", "tx_power_mode": "low"}), declared)
+	assert.Empty(t, owned)
+	assert.Equal(t, current, mergeWLANOverrides(radios, current, owned, declared))
+	assert.Empty(t, wlanOverridesFromDevice(current, "ng", owned))
+}
+
+func TestWLANOverridesFromDevice_FiltersByBand(t *testing.T) {
+	overrides := []unifi.DeviceWLANOverrides{
+		{Radio: "ng", WLANID: "wlan1", Name: "ng-ssid", Enabled: true, VLAN: 30, VLANEnabled: true},
+		{Radio: "na", WLANID: "wlan1", Name: "na-ssid", Enabled: true, VLAN: 40},
+		{Radio: "na", WLANID: "wlan2", Name: "ui-ssid", Enabled: true},
+	}
+	owned := map[wlanOverrideKey]bool{
+		{radio: "ng", wlanID: "wlan1"}: true,
+		{radio: "na", wlanID: "wlan1"}: true,
+	}
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"wlan_id": "wlan1", "enabled": true, "name": "ng-ssid", "vlan_id": 30, "passphrase": "",
+	}}, wlanOverridesFromDevice(overrides, "ng", owned))
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"wlan_id": "wlan1", "enabled": true, "name": "na-ssid", "vlan_id": 0, "passphrase": "",
+	}}, wlanOverridesFromDevice(overrides, "na", owned))
+	assert.Empty(t, wlanOverridesFromDevice(overrides, "6e", owned))
+}
+
+func portNumbers(pos []unifi.DevicePortOverrides) []int {
+	numbers := make([]int, 0, len(pos))
+	for _, po := range pos {
+		numbers = append(numbers, po.PortIDX)
+	}
+	return numbers
+}
+
+func TestMergePortOverrides_PreservesUnownedPorts(t *testing.T) {
+	current := []unifi.DevicePortOverrides{
+		{PortIDX: 1, Name: "old 1"},
+		{PortIDX: 2, Name: "owned elsewhere"},
+		{PortIDX: 3, Name: "removed from config"},
+	}
+	declared := []unifi.DevicePortOverrides{{PortIDX: 1, Name: "new 1"}}
+
+	merged := mergePortOverrides(current, map[int]bool{1: true, 3: true}, declared)
+	assert.ElementsMatch(t, []int{1, 2}, portNumbers(merged))
+	po, found := findPortOverride(merged, 1)
+	require.True(t, found)
+	assert.Equal(t, "new 1", po.Name)
+	po, found = findPortOverride(merged, 2)
+	require.True(t, found)
+	assert.Equal(t, "owned elsewhere", po.Name)
+}
+
+func TestPortOverrideNumbers_UnionOfSets(t *testing.T) {
+	oldSet := schema.NewSet(portOverrideSetHash, []interface{}{map[string]interface{}{"number": 1}, map[string]interface{}{"number": 3}})
+	newSet := schema.NewSet(portOverrideSetHash, []interface{}{map[string]interface{}{"number": 1}, map[string]interface{}{"number": 5}})
+	assert.Equal(t, map[int]bool{1: true, 3: true, 5: true}, portOverrideNumbers(oldSet, newSet, nil))
+}
+
+// Importing a device next to a unifi_device_port resource does not take over
+// the port it owns: only the ports declared afterwards are merged.
+func TestResourceDeviceImport_LeavesDevicePortPorts(t *testing.T) {
+	fake := &fakeDevicePortClient{device: unifi.Device{
+		ID:  "dev1",
+		MAC: "aa:bb:cc:dd:ee:ff",
+		PortOverrides: []unifi.DevicePortOverrides{
+			{PortIDX: 1, Name: "declared"},
+			{PortIDX: 5, Name: "unifi_device_port"},
+		},
+	}}
+	client := &base.Client{Client: fake, Site: "default"}
+
+	d := schema.TestResourceDataRaw(t, ResourceDevice().Schema, map[string]interface{}{})
+	d.SetId("aa:bb:cc:dd:ee:ff")
+	imported, err := resourceDeviceImport(context.Background(), d, client)
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+	assert.Equal(t, "dev1", imported[0].Id())
+	oldSet, _ := imported[0].Get("port_override").(*schema.Set)
+	assert.Zero(t, oldSet.Len())
+
+	newSet := schema.NewSet(portOverrideSetHash, []interface{}{map[string]interface{}{"number": 1}})
+	merged := mergePortOverrides(fake.device.PortOverrides, portOverrideNumbers(oldSet, newSet), []unifi.DevicePortOverrides{{PortIDX: 1, Name: "new"}})
+	assert.ElementsMatch(t, []int{1, 5}, portNumbers(merged))
+	po, found := findPortOverride(merged, 5)
+	require.True(t, found)
+	assert.Equal(t, "unifi_device_port", po.Name)
+}
+
+func TestFilterPortOverrides_OnlyManagedPorts(t *testing.T) {
+	pos := []unifi.DevicePortOverrides{{PortIDX: 1}, {PortIDX: 2}, {PortIDX: 3}}
+	assert.Equal(t, []int{1, 3}, portNumbers(filterPortOverrides(pos, map[int]bool{1: true, 3: true})))
+	assert.Empty(t, filterPortOverrides(pos, map[int]bool{}))
+}
@@ -40,11 +40,12 @@ func ResourcePortProfile() *schema.Resource {
 				Computed:    true,
 			},
 			"site": {
-				Description: "The name of the UniFi site where the port profile should be created. If not specified, the default site will be used.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				ForceNew:    true,
+				Description:      "The name of the UniFi site where the port profile should be created. If not specified, the default site will be used.",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: utils.SiteDiffSuppressFunc,
 			},
 			"autoneg": {
 				Description: "Enable automatic negotiation of port speed and duplex settings. When enabled, this overrides manual speed and duplex settings. Recommended for most use cases.",
@@ -385,9 +386,9 @@ func resourcePortProfileCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	resp, err := c.CreatePortProfile(ctx, site, req)
 	if err != nil {
@@ -541,9 +542,9 @@ func resourcePortProfileRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	resp, err := c.GetPortProfile(ctx, site, id)
 	if errors.Is(err, unifi.ErrNotFound) {
@@ -570,9 +571,9 @@ func resourcePortProfileUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	req.ID = d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	req.SiteID = site
 
@@ -604,11 +605,11 @@ func resourcePortProfileDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	err := c.DeletePortProfile(ctx, site, id)
+	err = c.DeletePortProfile(ctx, site, id)
 	return diag.FromErr(err)
 }
@@ -79,7 +79,11 @@ func (d *dnsRecordDatasource) Read(ctx context.Context, req datasource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	site := d.client.ResolveSite(&state)
+	site, diags := d.client.ResolveSite(&state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	list, err := d.client.ListDNSRecord(ctx, site)
 	if err != nil {
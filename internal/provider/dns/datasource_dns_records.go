@@ -72,7 +72,11 @@ func (d *dnsRecordsDatasource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	site := d.client.ResolveSite(&state)
+	site, diags := d.client.ResolveSite(&state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	records, err := d.client.ListDNSRecord(ctx, site)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to list DNS records", err.Error())
@@ -82,7 +86,7 @@ func (d *dnsRecordsDatasource) Read(ctx context.Context, req datasource.ReadRequ
 		state.Records = append(state.Records, &dnsRecordModel{
 			Model: base.Model{
 				ID:   types.StringValue(record.ID),
-				Site: types.StringValue(site),
+				Site: ut.NewSiteValue(site),
 			},
 			Name:     types.StringValue(record.Key),
 			Record:   types.StringValue(record.Value),
@@ -32,7 +32,7 @@ type dnsRecordModel struct {
 }
 
 type dnsRecordsDatasourceModel struct {
-	Site    types.String      `tfsdk:"site"`
+	Site    ut.SiteValue      `tfsdk:"site"`
 	Records []*dnsRecordModel `tfsdk:"result"`
 }
 
@@ -41,11 +41,11 @@ func (b *dnsRecordsDatasourceModel) GetSite() string {
 }
 
 func (b *dnsRecordsDatasourceModel) GetRawSite() types.String {
-	return b.Site
+	return b.Site.StringValue
 }
 
 func (b *dnsRecordsDatasourceModel) SetSite(site string) {
-	b.Site = types.StringValue(site)
+	b.Site = ut.NewSiteValue(site)
 }
 
 var dnsRecordDatasourceAttributes = map[string]schema.Attribute{
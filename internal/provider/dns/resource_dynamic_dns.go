@@ -41,11 +41,12 @@ func ResourceDynamicDNS() *schema.Resource {
 				Computed:    true,
 			},
 			"site": {
-				Description: "The name of the UniFi site where the dynamic DNS configuration should be created. If not specified, the default site will be used.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				ForceNew:    true,
+				Description:      "The name of the UniFi site where the dynamic DNS configuration should be created. If not specified, the default site will be used.",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: utils.SiteDiffSuppressFunc,
 			},
 			"interface": {
 				Description: "The WAN interface to use for the dynamic DNS updates. Valid values are:\n" +
@@ -104,12 +105,9 @@ func resourceDynamicDNSCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	site, ok := d.Get("site").(string)
-	if !ok {
-		return diag.FromErr(errors.New("`site` must be a string"))
-	}
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.CreateDynamicDNS(ctx, site, req)
@@ -193,12 +191,9 @@ func resourceDynamicDNSRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	id := d.Id()
 
-	site, ok := d.Get("site").(string)
-	if !ok {
-		return diag.FromErr(errors.New("`site` must be a string"))
-	}
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.GetDynamicDNS(ctx, site, id)
@@ -226,12 +221,9 @@ func resourceDynamicDNSUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	req.ID = d.Id()
 
-	site, ok := d.Get("site").(string)
-	if !ok {
-		return diag.FromErr(errors.New("`site` must be a string"))
-	}
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	req.SiteID = site
 
@@ -261,14 +253,11 @@ func resourceDynamicDNSDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	id := d.Id()
 
-	site, ok := d.Get("site").(string)
-	if !ok {
-		return diag.FromErr(errors.New("`site` must be a string"))
-	}
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	err := c.DeleteDynamicDNS(ctx, site, id)
+	err = c.DeleteDynamicDNS(ctx, site, id)
 	if errors.Is(err, unifi.ErrNotFound) {
 		return nil
 	}
@@ -0,0 +1,76 @@
+package firewall
+
+import (
+	"context"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+type firewallGroupListItemModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Members types.Set    `tfsdk:"members"`
+}
+
+var (
+	_ datasource.DataSource              = &firewallGroupsDatasource{}
+	_ datasource.DataSourceWithConfigure = &firewallGroupsDatasource{}
+	_ base.Resource                      = &firewallGroupsDatasource{}
+)
+
+type firewallGroupsDatasource struct {
+	*base.GenericListDatasource[unifi.FirewallGroup, firewallGroupListItemModel]
+}
+
+func NewFirewallGroupsDatasource() datasource.DataSource {
+	return &firewallGroupsDatasource{
+		GenericListDatasource: base.NewGenericListDatasource(
+			"unifi_firewall_groups",
+			"The `unifi_firewall_groups` data source lists the firewall groups of a site, optionally filtered by name or type.",
+			map[string]schema.Attribute{
+				"id": ut.ID("The ID of the firewall group."),
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The name of the firewall group.",
+					Computed:            true,
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The type of the firewall group, `address-group`, `port-group` or `ipv6-address-group`.",
+					Computed:            true,
+				},
+				"members": schema.SetAttribute{
+					MarkdownDescription: "The members of the firewall group.",
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+			},
+			base.ListFilters{
+				Purpose: "Only return firewall groups of this type, `address-group`, `port-group` or `ipv6-address-group`.",
+			},
+			base.ListDatasourceFunctions[unifi.FirewallGroup, firewallGroupListItemModel]{
+				List: func(ctx context.Context, client *base.Client, site string) ([]unifi.FirewallGroup, error) {
+					return client.ListFirewallGroup(ctx, site)
+				},
+				Describe: func(g unifi.FirewallGroup) base.ListItem {
+					return base.ListItem{Name: g.Name, Purpose: g.GroupType}
+				},
+				Convert: func(ctx context.Context, g unifi.FirewallGroup) (firewallGroupListItemModel, diag.Diagnostics) {
+					members, diags := types.SetValueFrom(ctx, types.StringType, g.GroupMembers)
+					return firewallGroupListItemModel{
+						ID:      types.StringValue(g.ID),
+						Name:    types.StringValue(g.Name),
+						Type:    types.StringValue(g.GroupType),
+						Members: members,
+					}, diags
+				},
+			},
+		),
+	}
+}
@@ -83,7 +83,11 @@ func (d *firewallZoneDatasource) Read(ctx context.Context, req datasource.ReadRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	site := d.client.ResolveSite(&state)
+	site, diags := d.client.ResolveSite(&state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	list, err := d.client.ListFirewallZone(ctx, site)
 	if err != nil {
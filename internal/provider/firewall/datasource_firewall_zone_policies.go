@@ -0,0 +1,114 @@
+package firewall
+
+import (
+	"context"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+type firewallZonePolicyListItemModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Action            types.String `tfsdk:"action"`
+	Protocol          types.String `tfsdk:"protocol"`
+	IPVersion         types.String `tfsdk:"ip_version"`
+	Index             types.Int64  `tfsdk:"index"`
+	SourceZoneID      types.String `tfsdk:"source_zone_id"`
+	DestinationZoneID types.String `tfsdk:"destination_zone_id"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+}
+
+var (
+	_ datasource.DataSource              = &firewallZonePoliciesDatasource{}
+	_ datasource.DataSourceWithConfigure = &firewallZonePoliciesDatasource{}
+	_ base.Resource                      = &firewallZonePoliciesDatasource{}
+)
+
+type firewallZonePoliciesDatasource struct {
+	*base.GenericListDatasource[unifi.FirewallZonePolicy, firewallZonePolicyListItemModel]
+}
+
+func NewFirewallZonePoliciesDatasource() datasource.DataSource {
+	return &firewallZonePoliciesDatasource{
+		GenericListDatasource: base.NewGenericListDatasource(
+			"unifi_firewall_zone_policies",
+			"The `unifi_firewall_zone_policies` data source lists the firewall zone policies of a site, optionally filtered "+
+				"by name, action or enabled state. Requires controller version 9.0 or later with zone-based firewall enabled.",
+			map[string]schema.Attribute{
+				"id": ut.ID("The ID of the firewall zone policy."),
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The name of the firewall zone policy.",
+					Computed:            true,
+				},
+				"action": schema.StringAttribute{
+					MarkdownDescription: "The action of the policy, `ALLOW`, `BLOCK` or `REJECT`.",
+					Computed:            true,
+				},
+				"protocol": schema.StringAttribute{
+					MarkdownDescription: "The protocol the policy matches.",
+					Computed:            true,
+				},
+				"ip_version": schema.StringAttribute{
+					MarkdownDescription: "The IP version the policy matches, `BOTH`, `IPV4` or `IPV6`.",
+					Computed:            true,
+				},
+				"index": schema.Int64Attribute{
+					MarkdownDescription: "The position of the policy among the policies of its zone pair.",
+					Computed:            true,
+				},
+				"source_zone_id": schema.StringAttribute{
+					MarkdownDescription: "The ID of the source firewall zone.",
+					Computed:            true,
+				},
+				"destination_zone_id": schema.StringAttribute{
+					MarkdownDescription: "The ID of the destination firewall zone.",
+					Computed:            true,
+				},
+				"enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether the policy is enabled.",
+					Computed:            true,
+				},
+			},
+			base.ListFilters{
+				Purpose: "Only return policies with this action, `ALLOW`, `BLOCK` or `REJECT`.",
+				Enabled: true,
+			},
+			base.ListDatasourceFunctions[unifi.FirewallZonePolicy, firewallZonePolicyListItemModel]{
+				List: func(ctx context.Context, client *base.Client, site string) ([]unifi.FirewallZonePolicy, error) {
+					return client.ListFirewallZonePolicy(ctx, site)
+				},
+				Describe: func(p unifi.FirewallZonePolicy) base.ListItem {
+					return base.ListItem{Name: p.Name, Purpose: p.Action, Enabled: p.Enabled}
+				},
+				Convert: func(_ context.Context, p unifi.FirewallZonePolicy) (firewallZonePolicyListItemModel, diag.Diagnostics) {
+					return firewallZonePolicyListItemModel{
+						ID:                types.StringValue(p.ID),
+						Name:              types.StringValue(p.Name),
+						Action:            types.StringValue(p.Action),
+						Protocol:          types.StringValue(p.Protocol),
+						IPVersion:         types.StringValue(p.IPVersion),
+						Index:             types.Int64Value(int64(p.Index)),
+						SourceZoneID:      types.StringValue(p.Source.ZoneID),
+						DestinationZoneID: types.StringValue(p.Destination.ZoneID),
+						Enabled:           types.BoolValue(p.Enabled),
+					}, nil
+				},
+			},
+		),
+	}
+}
+
+func (d *firewallZonePoliciesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(d.RequireMinVersion("9.0.0")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.GenericListDatasource.Read(ctx, req, resp)
+}
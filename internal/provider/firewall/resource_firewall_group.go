@@ -42,11 +42,12 @@ func ResourceFirewallGroup() *schema.Resource {
 				Computed:    true,
 			},
 			"site": {
-				Description: "The name of the UniFi site where the firewall group should be created. If not specified, the default site will be used.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				ForceNew:    true,
+				Description:      "The name of the UniFi site where the firewall group should be created. If not specified, the default site will be used.",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: utils.SiteDiffSuppressFunc,
 			},
 			"name": {
 				Description: "A friendly name for the firewall group to help identify its purpose (e.g., 'Trusted IPs' or 'Web Server Ports'). " +
@@ -87,9 +88,9 @@ func resourceFirewallGroupCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.CreateFirewallGroup(ctx, site, req)
@@ -147,9 +148,9 @@ func resourceFirewallGroupRead(ctx context.Context, d *schema.ResourceData, meta
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.GetFirewallGroup(ctx, site, id)
@@ -177,9 +178,9 @@ func resourceFirewallGroupUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	req.ID = d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	req.SiteID = site
 
@@ -209,12 +210,12 @@ func resourceFirewallGroupDelete(ctx context.Context, d *schema.ResourceData, me
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	err := c.DeleteFirewallGroup(ctx, site, id)
+	err = c.DeleteFirewallGroup(ctx, site, id)
 	if errors.Is(err, unifi.ErrNotFound) {
 		return nil
 	}
@@ -46,11 +46,12 @@ func ResourceFirewallRule() *schema.Resource {
 				Computed:    true,
 			},
 			"site": {
-				Description: "The name of the UniFi site where the firewall rule should be created. If not specified, the default site will be used.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				ForceNew:    true,
+				Description:      "The name of the UniFi site where the firewall rule should be created. If not specified, the default site will be used.",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: utils.SiteDiffSuppressFunc,
 			},
 			"name": {
 				Description: "A friendly name for the firewall rule. This helps identify the rule's purpose in the UniFi controller UI.",
@@ -293,9 +294,9 @@ func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.CreateFirewallRule(ctx, site, req)
@@ -442,9 +443,9 @@ func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.GetFirewallRule(ctx, site, id)
@@ -472,9 +473,9 @@ func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, met
 
 	req.ID = d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	req.SiteID = site
 
@@ -504,11 +505,11 @@ func resourceFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, met
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	err := c.DeleteFirewallRule(ctx, site, id)
+	err = c.DeleteFirewallRule(ctx, site, id)
 	if errors.Is(err, unifi.ErrNotFound) {
 		return nil
 	}
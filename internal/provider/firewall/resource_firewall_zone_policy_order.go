@@ -344,7 +344,11 @@ func (r *firewallZonePolicyOrderResource) ModifyPlan(ctx context.Context, req re
 // result after apply" error (issue #122). Reconstruction happens only in Read /
 // ImportState, where it reflects observed controller state rather than the plan.
 func (r *firewallZonePolicyOrderResource) applyReorder(ctx context.Context, model *FirewallZonePolicyOrderModel, diags *diag.Diagnostics) {
-	site := r.GetClient().ResolveSite(model)
+	site, d := r.GetClient().ResolveSite(model)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
 
 	body, d := model.AsUnifiModel(ctx)
 	diags.Append(d...)
@@ -458,7 +462,11 @@ func (r *firewallZonePolicyOrderResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	site := r.GetClient().ResolveSite(&model)
+	site, diags := r.GetClient().ResolveSite(&model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Subset ownership (FIX 2): this resource manages only the custom policies it
 	// already lists. Capture that set from prior state BEFORE reconstructing, so
@@ -500,7 +508,7 @@ func (r *firewallZonePolicyOrderResource) ImportState(ctx context.Context, req r
 
 	// ImportIDWithSite splits on the FIRST colon, so `site:source:dest` yields
 	// site=`site`, id=`source:dest`.
-	id, site := base.ImportIDWithSite(req, resp)
+	id, site := base.ImportIDWithSite(r.GetClient(), req, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
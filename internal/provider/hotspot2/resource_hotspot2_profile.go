@@ -0,0 +1,623 @@
+package hotspot2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/validators"
+)
+
+const hotspot2Collection = "hotspot2conf"
+
+var (
+	_ resource.Resource                     = &hotspot2ProfileResource{}
+	_ resource.ResourceWithConfigure        = &hotspot2ProfileResource{}
+	_ resource.ResourceWithImportState      = &hotspot2ProfileResource{}
+	_ resource.ResourceWithConfigValidators = &hotspot2ProfileResource{}
+	_ base.Resource                         = &hotspot2ProfileResource{}
+	_ base.ResourceModel                    = &hotspot2ProfileModel{}
+)
+
+// networkTypes maps the access network type names exposed in the schema to the
+// IEEE 802.11u codes the controller stores.
+var networkTypes = map[string]int{
+	"private":            0,
+	"private-with-guest": 1,
+	"chargeable-public":  2,
+	"free-public":        3,
+	"personal-device":    4,
+	"emergency-services": 5,
+	"test":               14,
+	"wildcard":           15,
+}
+
+// networkAuthTypes maps the network authentication type names exposed in the
+// schema to the IEEE 802.11u codes the controller stores.
+var networkAuthTypes = map[string]int{
+	"acceptance-of-terms": 0,
+	"online-enrollment":   1,
+	"http-redirection":    2,
+	"dns-redirection":     3,
+}
+
+// eapMethods maps the EAP method names exposed in the schema to their IANA
+// method numbers.
+var eapMethods = map[string]int{
+	"eap-tls":       13,
+	"eap-sim":       18,
+	"eap-ttls":      21,
+	"eap-aka":       23,
+	"eap-aka-prime": 50,
+}
+
+// hotspot2Conf is the controller's hotspot2conf object. It is accessed directly
+// through the REST collection, modelling only the fields this resource manages.
+type hotspot2Conf struct {
+	ID     string `json:"_id,omitempty"`
+	SiteID string `json:"site_id,omitempty"`
+
+	Name                  string                      `json:"name"`
+	VenueGroup            int                         `json:"venue_group"`
+	VenueType             int                         `json:"venue_type"`
+	VenueName             []hotspot2VenueName         `json:"venue_name"`
+	FriendlyName          []hotspot2FriendlyName      `json:"friendly_name"`
+	DomainNameList        []string                    `json:"domain_name_list"`
+	NaiRealmList          []hotspot2NaiRealm          `json:"nai_realm_list"`
+	RoamingConsortiumList []hotspot2RoamingConsortium `json:"roaming_consortium_list"`
+	CellularNetworkList   []hotspot2CellularNetwork   `json:"cellular_network_list"`
+	NetworkType           int                         `json:"network_type"`
+	NetworkAccessInternet bool                        `json:"network_access_internet"`
+	// NetworkAuthType and NetworkAuthURL are always sent, a null type and an
+	// empty URL clearing them, as the controller keeps omitted fields.
+	NetworkAuthType *int   `json:"network_auth_type"`
+	NetworkAuthURL  string `json:"network_auth_url"`
+}
+
+type hotspot2VenueName struct {
+	Language string `json:"language"`
+	Name     string `json:"name"`
+}
+
+type hotspot2FriendlyName struct {
+	Language string `json:"language"`
+	Text     string `json:"text"`
+}
+
+type hotspot2NaiRealm struct {
+	Name      string `json:"name"`
+	Encoding  int    `json:"encoding"`
+	EapMethod int    `json:"eap_method"`
+	Status    bool   `json:"status"`
+}
+
+type hotspot2RoamingConsortium struct {
+	Name string `json:"name"`
+	OI   string `json:"oi"`
+}
+
+type hotspot2CellularNetwork struct {
+	Name string `json:"name"`
+	MCC  int    `json:"mcc"`
+	MNC  int    `json:"mnc"`
+}
+
+type hotspot2NameModel struct {
+	Language types.String `tfsdk:"language"`
+	Name     types.String `tfsdk:"name"`
+}
+
+func (m *hotspot2NameModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"language": types.StringType,
+		"name":     types.StringType,
+	}
+}
+
+type hotspot2NaiRealmModel struct {
+	Name      types.String `tfsdk:"name"`
+	Encoding  types.String `tfsdk:"encoding"`
+	EapMethod types.String `tfsdk:"eap_method"`
+}
+
+func (m *hotspot2NaiRealmModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":       types.StringType,
+		"encoding":   types.StringType,
+		"eap_method": types.StringType,
+	}
+}
+
+type hotspot2RoamingConsortiumModel struct {
+	Name types.String `tfsdk:"name"`
+	OI   types.String `tfsdk:"oi"`
+}
+
+func (m *hotspot2RoamingConsortiumModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name": types.StringType,
+		"oi":   types.StringType,
+	}
+}
+
+type hotspot2PLMNModel struct {
+	Name types.String `tfsdk:"name"`
+	MCC  types.Int64  `tfsdk:"mcc"`
+	MNC  types.Int64  `tfsdk:"mnc"`
+}
+
+func (m *hotspot2PLMNModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name": types.StringType,
+		"mcc":  types.Int64Type,
+		"mnc":  types.Int64Type,
+	}
+}
+
+// hotspot2ProfileModel represents the data model for a Hotspot 2.0 (Passpoint) profile.
+type hotspot2ProfileModel struct {
+	base.Model
+	Name                  types.String `tfsdk:"name"`
+	VenueGroup            types.Int64  `tfsdk:"venue_group"`
+	VenueType             types.Int64  `tfsdk:"venue_type"`
+	VenueNames            types.List   `tfsdk:"venue_names"`
+	OperatorNames         types.List   `tfsdk:"operator_names"`
+	DomainNames           types.List   `tfsdk:"domain_names"`
+	NaiRealms             types.List   `tfsdk:"nai_realms"`
+	RoamingConsortiumOIs  types.List   `tfsdk:"roaming_consortium_ois"`
+	PLMNIDs               types.List   `tfsdk:"plmn_ids"`
+	NetworkType           types.String `tfsdk:"network_type"`
+	NetworkAccessInternet types.Bool   `tfsdk:"network_access_internet"`
+	NetworkAuthType       types.String `tfsdk:"network_auth_type"`
+	NetworkAuthURL        types.String `tfsdk:"network_auth_url"`
+}
+
+// AsUnifiModel converts the Terraform model to the UniFi API model.
+func (m *hotspot2ProfileModel) AsUnifiModel(ctx context.Context) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	var venueNames, operatorNames []hotspot2NameModel
+	var realms []hotspot2NaiRealmModel
+	var ois []hotspot2RoamingConsortiumModel
+	var plmns []hotspot2PLMNModel
+	var domainNames []string
+	diags.Append(ut.ListElementsAs(ctx, m.VenueNames, &venueNames)...)
+	diags.Append(ut.ListElementsAs(ctx, m.OperatorNames, &operatorNames)...)
+	diags.Append(ut.ListElementsAs(ctx, m.NaiRealms, &realms)...)
+	diags.Append(ut.ListElementsAs(ctx, m.RoamingConsortiumOIs, &ois)...)
+	diags.Append(ut.ListElementsAs(ctx, m.PLMNIDs, &plmns)...)
+	diags.Append(ut.ListElementsAs(ctx, m.DomainNames, &domainNames)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	model := &hotspot2Conf{
+		ID:                    m.ID.ValueString(),
+		Name:                  m.Name.ValueString(),
+		VenueGroup:            int(m.VenueGroup.ValueInt64()),
+		VenueType:             int(m.VenueType.ValueInt64()),
+		VenueName:             make([]hotspot2VenueName, 0, len(venueNames)),
+		FriendlyName:          make([]hotspot2FriendlyName, 0, len(operatorNames)),
+		DomainNameList:        domainNames,
+		NaiRealmList:          make([]hotspot2NaiRealm, 0, len(realms)),
+		RoamingConsortiumList: make([]hotspot2RoamingConsortium, 0, len(ois)),
+		CellularNetworkList:   make([]hotspot2CellularNetwork, 0, len(plmns)),
+		NetworkType:           networkTypes[m.NetworkType.ValueString()],
+		NetworkAccessInternet: m.NetworkAccessInternet.ValueBool(),
+		NetworkAuthURL:        m.NetworkAuthURL.ValueString(),
+	}
+	if model.DomainNameList == nil {
+		model.DomainNameList = []string{}
+	}
+	if ut.IsDefined(m.NetworkAuthType) {
+		authType := networkAuthTypes[m.NetworkAuthType.ValueString()]
+		model.NetworkAuthType = &authType
+	}
+	for _, v := range venueNames {
+		model.VenueName = append(model.VenueName, hotspot2VenueName{Language: v.Language.ValueString(), Name: v.Name.ValueString()})
+	}
+	for _, v := range operatorNames {
+		model.FriendlyName = append(model.FriendlyName, hotspot2FriendlyName{Language: v.Language.ValueString(), Text: v.Name.ValueString()})
+	}
+	for _, r := range realms {
+		encoding := 0
+		if r.Encoding.ValueString() == "utf8" {
+			encoding = 1
+		}
+		model.NaiRealmList = append(model.NaiRealmList, hotspot2NaiRealm{
+			Name:      r.Name.ValueString(),
+			Encoding:  encoding,
+			EapMethod: eapMethods[r.EapMethod.ValueString()],
+			Status:    true,
+		})
+	}
+	for _, o := range ois {
+		model.RoamingConsortiumList = append(model.RoamingConsortiumList, hotspot2RoamingConsortium{Name: o.Name.ValueString(), OI: o.OI.ValueString()})
+	}
+	for _, p := range plmns {
+		model.CellularNetworkList = append(model.CellularNetworkList, hotspot2CellularNetwork{
+			Name: p.Name.ValueString(),
+			MCC:  int(p.MCC.ValueInt64()),
+			MNC:  int(p.MNC.ValueInt64()),
+		})
+	}
+	return model, diags
+}
+
+// Merge updates the Terraform model with values from the UniFi API model.
+func (m *hotspot2ProfileModel) Merge(ctx context.Context, other interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	model, ok := other.(*hotspot2Conf)
+	if !ok {
+		diags.AddError("Invalid model type", fmt.Sprintf("Expected *hotspot2Conf, got: %T", other))
+		return diags
+	}
+
+	m.ID = types.StringValue(model.ID)
+	m.Name = types.StringValue(model.Name)
+	m.VenueGroup = types.Int64Value(int64(model.VenueGroup))
+	m.VenueType = types.Int64Value(int64(model.VenueType))
+	networkType, err := keyForValue(networkTypes, model.NetworkType)
+	if err != nil {
+		diags.AddError("Unsupported network type", err.Error())
+		return diags
+	}
+	m.NetworkType = types.StringValue(networkType)
+	m.NetworkAccessInternet = types.BoolValue(model.NetworkAccessInternet)
+	m.NetworkAuthURL = ut.StringOrNull(model.NetworkAuthURL)
+	m.NetworkAuthType = types.StringNull()
+	if model.NetworkAuthType != nil && *model.NetworkAuthType >= 0 {
+		networkAuthType, err := keyForValue(networkAuthTypes, *model.NetworkAuthType)
+		if err != nil {
+			diags.AddError("Unsupported network authentication type", err.Error())
+			return diags
+		}
+		m.NetworkAuthType = types.StringValue(networkAuthType)
+	}
+
+	venueNames := make([]hotspot2NameModel, 0, len(model.VenueName))
+	for _, v := range model.VenueName {
+		venueNames = append(venueNames, hotspot2NameModel{Language: types.StringValue(v.Language), Name: types.StringValue(v.Name)})
+	}
+	operatorNames := make([]hotspot2NameModel, 0, len(model.FriendlyName))
+	for _, v := range model.FriendlyName {
+		operatorNames = append(operatorNames, hotspot2NameModel{Language: types.StringValue(v.Language), Name: types.StringValue(v.Text)})
+	}
+	realms := make([]hotspot2NaiRealmModel, 0, len(model.NaiRealmList))
+	for _, r := range model.NaiRealmList {
+		encoding := "rfc4282"
+		if r.Encoding == 1 {
+			encoding = "utf8"
+		}
+		eapMethod, err := keyForValue(eapMethods, r.EapMethod)
+		if err != nil {
+			diags.AddError("Unsupported EAP method", fmt.Sprintf("NAI realm %q: %s", r.Name, err))
+			return diags
+		}
+		realms = append(realms, hotspot2NaiRealmModel{
+			Name:      types.StringValue(r.Name),
+			Encoding:  types.StringValue(encoding),
+			EapMethod: types.StringValue(eapMethod),
+		})
+	}
+	ois := make([]hotspot2RoamingConsortiumModel, 0, len(model.RoamingConsortiumList))
+	for _, o := range model.RoamingConsortiumList {
+		ois = append(ois, hotspot2RoamingConsortiumModel{Name: types.StringValue(o.Name), OI: types.StringValue(o.OI)})
+	}
+	plmns := make([]hotspot2PLMNModel, 0, len(model.CellularNetworkList))
+	for _, p := range model.CellularNetworkList {
+		plmns = append(plmns, hotspot2PLMNModel{
+			Name: types.StringValue(p.Name),
+			MCC:  types.Int64Value(int64(p.MCC)),
+			MNC:  types.Int64Value(int64(p.MNC)),
+		})
+	}
+
+	var d diag.Diagnostics
+	m.VenueNames, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: (&hotspot2NameModel{}).AttributeTypes()}, venueNames)
+	diags.Append(d...)
+	m.OperatorNames, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: (&hotspot2NameModel{}).AttributeTypes()}, operatorNames)
+	diags.Append(d...)
+	m.NaiRealms, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: (&hotspot2NaiRealmModel{}).AttributeTypes()}, realms)
+	diags.Append(d...)
+	m.RoamingConsortiumOIs, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: (&hotspot2RoamingConsortiumModel{}).AttributeTypes()}, ois)
+	diags.Append(d...)
+	m.PLMNIDs, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: (&hotspot2PLMNModel{}).AttributeTypes()}, plmns)
+	diags.Append(d...)
+	domainNames := model.DomainNameList
+	if domainNames == nil {
+		domainNames = []string{}
+	}
+	m.DomainNames, d = types.ListValueFrom(ctx, types.StringType, domainNames)
+	diags.Append(d...)
+
+	return diags
+}
+
+// keyForValue returns the schema name mapped to the given controller code. A
+// code not known to the provider, e.g. set in the UniFi UI, is an error rather
+// than an empty name, which would silently plan to overwrite it.
+func keyForValue(m map[string]int, value int) (string, error) {
+	for k, v := range m {
+		if v == value {
+			return k, nil
+		}
+	}
+	return "", fmt.Errorf("the controller returned code %d, which is not supported by the provider; supported values are: %s", value, strings.Join(sortedKeys(m), ", "))
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+type hotspot2ProfileResource struct {
+	*base.GenericResource[*hotspot2ProfileModel]
+}
+
+// NewHotspot2ProfileResource creates a new instance of the Hotspot 2.0 profile resource.
+func NewHotspot2ProfileResource() resource.Resource {
+	return &hotspot2ProfileResource{
+		GenericResource: base.NewGenericResource(
+			"unifi_hotspot2_profile",
+			func() *hotspot2ProfileModel { return &hotspot2ProfileModel{} },
+			base.ResourceFunctions{
+				Read: func(ctx context.Context, client *base.Client, site, id string) (interface{}, error) {
+					return base.GetRest[hotspot2Conf](ctx, client, site, hotspot2Collection, id)
+				},
+				Create: func(ctx context.Context, client *base.Client, site string, model interface{}) (interface{}, error) {
+					m, ok := model.(*hotspot2Conf)
+					if !ok {
+						return nil, fmt.Errorf("unexpected model type: %T", model)
+					}
+					m.SiteID = site
+					return base.CreateRest(ctx, client, site, hotspot2Collection, m)
+				},
+				Update: func(ctx context.Context, client *base.Client, site string, model interface{}) (interface{}, error) {
+					m, ok := model.(*hotspot2Conf)
+					if !ok {
+						return nil, fmt.Errorf("unexpected model type: %T", model)
+					}
+					m.SiteID = site
+					return base.UpdateRest(ctx, client, site, hotspot2Collection, m.ID, m)
+				},
+				Delete: func(ctx context.Context, client *base.Client, site, id string) error {
+					return base.DeleteRest(ctx, client, site, hotspot2Collection, id)
+				},
+			},
+		),
+	}
+}
+
+func (r *hotspot2ProfileResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.RequiredTogetherIf(path.MatchRoot("network_auth_type"), types.StringValue("http-redirection"), path.MatchRoot("network_auth_url")),
+	}
+}
+
+var (
+	languageValidator = stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]{2,3}$`), "must be an ISO-639 language code, e.g. `eng`")
+	oiValidator       = stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9A-Fa-f]{6}|[0-9A-Fa-f]{10})$`), "must be a 24-bit or 36-bit organization identifier in hexadecimal, e.g. `5A03BA` or `004096`")
+)
+
+func nameAttributes(nameDescription string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"language": schema.StringAttribute{
+			MarkdownDescription: "ISO-639 language code of the name. Defaults to `eng`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("eng"),
+			Validators:          []validator.String{languageValidator},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: nameDescription,
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 252),
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *hotspot2ProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_hotspot2_profile` resource manages Hotspot 2.0 (Passpoint) profiles in the UniFi controller.\n\n" +
+			"A Hotspot 2.0 profile describes the venue, operator and roaming partners advertised over ANQP, allowing " +
+			"Passpoint-capable clients to discover and automatically join the network. Profiles are attached to a " +
+			"WPA Enterprise `unifi_wlan` using its `hotspot2conf_id` attribute.",
+
+		Attributes: map[string]schema.Attribute{
+			"id":   ut.ID(),
+			"site": ut.SiteAttribute(),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Hotspot 2.0 profile.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"venue_group": schema.Int64Attribute{
+				MarkdownDescription: "IEEE 802.11u venue group code (e.g. `1` for assembly, `2` for business, `7` for residential). Defaults to `0` (unspecified).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 11),
+				},
+			},
+			"venue_type": schema.Int64Attribute{
+				MarkdownDescription: "IEEE 802.11u venue type code within the venue group. Defaults to `0` (unspecified).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 15),
+				},
+			},
+			"venue_names": schema.ListNestedAttribute{
+				MarkdownDescription: "Venue names advertised to clients, one per language.",
+				Optional:            true,
+				Computed:            true,
+				Default:             ut.DefaultEmptyList(types.ObjectType{AttrTypes: (&hotspot2NameModel{}).AttributeTypes()}),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: nameAttributes("The venue name."),
+				},
+			},
+			"operator_names": schema.ListNestedAttribute{
+				MarkdownDescription: "Operator friendly names advertised to clients, one per language.",
+				Optional:            true,
+				Computed:            true,
+				Default:             ut.DefaultEmptyList(types.ObjectType{AttrTypes: (&hotspot2NameModel{}).AttributeTypes()}),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: nameAttributes("The operator friendly name."),
+				},
+			},
+			"domain_names": schema.ListAttribute{
+				MarkdownDescription: "Domain names of the operator, used by clients to identify their home network.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             ut.DefaultEmptyList(types.StringType),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.Hostname()),
+				},
+			},
+			"nai_realms": schema.ListNestedAttribute{
+				MarkdownDescription: "Network Access Identifier (NAI) realms that can authenticate on this network.",
+				Optional:            true,
+				Computed:            true,
+				Default:             ut.DefaultEmptyList(types.ObjectType{AttrTypes: (&hotspot2NaiRealmModel{}).AttributeTypes()}),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The NAI realm, e.g. `example.com`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"encoding": schema.StringAttribute{
+							MarkdownDescription: "Encoding of the realm name. Valid values are `rfc4282` and `utf8`. Defaults to `rfc4282`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("rfc4282"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("rfc4282", "utf8"),
+							},
+						},
+						"eap_method": schema.StringAttribute{
+							MarkdownDescription: "EAP method used to authenticate with the realm. Valid values are " +
+								"`eap-tls`, `eap-sim`, `eap-ttls`, `eap-aka` and `eap-aka-prime`.",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(sortedKeys(eapMethods)...),
+							},
+						},
+					},
+				},
+			},
+			"roaming_consortium_ois": schema.ListNestedAttribute{
+				MarkdownDescription: "Roaming consortium organization identifiers (OIs) of the roaming partners. At most 3 OIs are advertised in beacons.",
+				Optional:            true,
+				Computed:            true,
+				Default:             ut.DefaultEmptyList(types.ObjectType{AttrTypes: (&hotspot2RoamingConsortiumModel{}).AttributeTypes()}),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Friendly name of the roaming consortium.",
+							Required:            true,
+						},
+						"oi": schema.StringAttribute{
+							MarkdownDescription: "The organization identifier in hexadecimal, 6 or 10 characters long.",
+							Required:            true,
+							Validators:          []validator.String{oiValidator},
+						},
+					},
+				},
+			},
+			"plmn_ids": schema.ListNestedAttribute{
+				MarkdownDescription: "3GPP cellular networks (PLMN IDs) whose subscribers can authenticate on this network.",
+				Optional:            true,
+				Computed:            true,
+				Default:             ut.DefaultEmptyList(types.ObjectType{AttrTypes: (&hotspot2PLMNModel{}).AttributeTypes()}),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Friendly name of the cellular network.",
+							Required:            true,
+						},
+						"mcc": schema.Int64Attribute{
+							MarkdownDescription: "Mobile Country Code.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 999),
+							},
+						},
+						"mnc": schema.Int64Attribute{
+							MarkdownDescription: "Mobile Network Code.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 999),
+							},
+						},
+					},
+				},
+			},
+			"network_type": schema.StringAttribute{
+				MarkdownDescription: "Access network type advertised to clients. Valid values are `private`, `private-with-guest`, " +
+					"`chargeable-public`, `free-public`, `personal-device`, `emergency-services`, `test` and `wildcard`. Defaults to `private`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("private"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(sortedKeys(networkTypes)...),
+				},
+			},
+			"network_access_internet": schema.BoolAttribute{
+				MarkdownDescription: "Whether the network provides internet access. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"network_auth_type": schema.StringAttribute{
+				MarkdownDescription: "Network authentication type required before access is granted. Valid values are " +
+					"`acceptance-of-terms`, `online-enrollment`, `http-redirection` and `dns-redirection`. " +
+					"When not set, no additional authentication step is advertised.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(sortedKeys(networkAuthTypes)...),
+				},
+			},
+			"network_auth_url": schema.StringAttribute{
+				MarkdownDescription: "Redirect URL for the network authentication step. Required when `network_auth_type` is `http-redirection`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.URL(),
+				},
+			},
+		},
+	}
+}
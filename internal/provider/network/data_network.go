@@ -311,10 +311,10 @@ func dataNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface
 	}
 
 	name, _ := d.Get("name").(string)
-	site, _ := d.Get("site").(string)
 	id, _ := d.Get("id").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	if (name == "" && id == "") || (name != "" && id != "") {
 		return diag.Errorf("One of 'name' OR 'id' is required")
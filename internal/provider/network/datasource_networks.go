@@ -0,0 +1,110 @@
+package network
+
+import (
+	"context"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
+)
+
+type networkListItemModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Purpose      types.String `tfsdk:"purpose"`
+	VLAN         types.Int64  `tfsdk:"vlan_id"`
+	Subnet       types.String `tfsdk:"subnet"`
+	NetworkGroup types.String `tfsdk:"network_group"`
+	DomainName   types.String `tfsdk:"domain_name"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+}
+
+// networkVLAN returns the VLAN ID of the network, 0 when it is untagged.
+func networkVLAN(n unifi.Network) int {
+	if n.VLANEnabled {
+		return n.VLAN
+	}
+	return 0
+}
+
+var (
+	_ datasource.DataSource              = &networksDatasource{}
+	_ datasource.DataSourceWithConfigure = &networksDatasource{}
+	_ base.Resource                      = &networksDatasource{}
+)
+
+type networksDatasource struct {
+	*base.GenericListDatasource[unifi.Network, networkListItemModel]
+}
+
+func NewNetworksDatasource() datasource.DataSource {
+	return &networksDatasource{
+		GenericListDatasource: base.NewGenericListDatasource(
+			"unifi_networks",
+			"The `unifi_networks` data source lists the networks of a site, optionally filtered by name, purpose, VLAN ID range "+
+				"or enabled state.",
+			map[string]schema.Attribute{
+				"id": ut.ID("The ID of the network."),
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The name of the network.",
+					Computed:            true,
+				},
+				"purpose": schema.StringAttribute{
+					MarkdownDescription: "The purpose of the network, e.g. `corporate`, `guest`, `wan` or `vlan-only`.",
+					Computed:            true,
+				},
+				"vlan_id": schema.Int64Attribute{
+					MarkdownDescription: "The VLAN ID of the network, `0` when untagged.",
+					Computed:            true,
+				},
+				"subnet": schema.StringAttribute{
+					MarkdownDescription: "The subnet of the network in CIDR notation.",
+					Computed:            true,
+				},
+				"network_group": schema.StringAttribute{
+					MarkdownDescription: "The network group, e.g. `LAN`.",
+					Computed:            true,
+				},
+				"domain_name": schema.StringAttribute{
+					MarkdownDescription: "The domain name of the network.",
+					Computed:            true,
+				},
+				"enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether the network is enabled.",
+					Computed:            true,
+				},
+			},
+			base.ListFilters{
+				Purpose: "Only return networks of this purpose, e.g. `corporate`, `guest`, `wan` or `vlan-only`.",
+				VLAN:    true,
+				Enabled: true,
+			},
+			base.ListDatasourceFunctions[unifi.Network, networkListItemModel]{
+				List: func(ctx context.Context, client *base.Client, site string) ([]unifi.Network, error) {
+					return client.ListNetwork(ctx, site)
+				},
+				Describe: func(n unifi.Network) base.ListItem {
+					return base.ListItem{Name: n.Name, Purpose: n.Purpose, VLAN: networkVLAN(n), Enabled: n.Enabled}
+				},
+				Convert: func(_ context.Context, n unifi.Network) (networkListItemModel, diag.Diagnostics) {
+					return networkListItemModel{
+						ID:           types.StringValue(n.ID),
+						Name:         types.StringValue(n.Name),
+						Purpose:      types.StringValue(n.Purpose),
+						VLAN:         types.Int64Value(int64(networkVLAN(n))),
+						Subnet:       ut.StringOrNull(utils.CidrZeroBased(n.IPSubnet)),
+						NetworkGroup: types.StringValue(n.NetworkGroup),
+						DomainName:   types.StringValue(n.DomainName),
+						Enabled:      types.BoolValue(n.Enabled),
+					}, nil
+				},
+			},
+		),
+	}
+}
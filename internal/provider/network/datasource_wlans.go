@@ -0,0 +1,106 @@
+package network
+
+import (
+	"context"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+type wlanListItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Security    types.String `tfsdk:"security"`
+	NetworkID   types.String `tfsdk:"network_id"`
+	UserGroupID types.String `tfsdk:"user_group_id"`
+	WLANBand    types.String `tfsdk:"wlan_band"`
+	IsGuest     types.Bool   `tfsdk:"is_guest"`
+	HideSSID    types.Bool   `tfsdk:"hide_ssid"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+var (
+	_ datasource.DataSource              = &wlansDatasource{}
+	_ datasource.DataSourceWithConfigure = &wlansDatasource{}
+	_ base.Resource                      = &wlansDatasource{}
+)
+
+type wlansDatasource struct {
+	*base.GenericListDatasource[unifi.WLAN, wlanListItemModel]
+}
+
+func NewWLANsDatasource() datasource.DataSource {
+	return &wlansDatasource{
+		GenericListDatasource: base.NewGenericListDatasource(
+			"unifi_wlans",
+			"The `unifi_wlans` data source lists the wireless networks (SSIDs) of a site, optionally filtered by name, "+
+				"security protocol or enabled state.",
+			map[string]schema.Attribute{
+				"id": ut.ID("The ID of the WLAN."),
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The SSID of the WLAN.",
+					Computed:            true,
+				},
+				"security": schema.StringAttribute{
+					MarkdownDescription: "The security protocol of the WLAN, `wpapsk`, `wpaeap` or `open`.",
+					Computed:            true,
+				},
+				"network_id": schema.StringAttribute{
+					MarkdownDescription: "The ID of the network the WLAN is bridged to.",
+					Computed:            true,
+				},
+				"user_group_id": schema.StringAttribute{
+					MarkdownDescription: "The ID of the user group of the WLAN.",
+					Computed:            true,
+				},
+				"wlan_band": schema.StringAttribute{
+					MarkdownDescription: "The radio band the WLAN is broadcast on, `2g`, `5g` or `both`.",
+					Computed:            true,
+				},
+				"is_guest": schema.BoolAttribute{
+					MarkdownDescription: "Whether the WLAN is a guest network.",
+					Computed:            true,
+				},
+				"hide_ssid": schema.BoolAttribute{
+					MarkdownDescription: "Whether the SSID is hidden.",
+					Computed:            true,
+				},
+				"enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether the WLAN is enabled.",
+					Computed:            true,
+				},
+			},
+			base.ListFilters{
+				Purpose: "Only return WLANs with this security protocol, `wpapsk`, `wpaeap` or `open`.",
+				Enabled: true,
+			},
+			base.ListDatasourceFunctions[unifi.WLAN, wlanListItemModel]{
+				List: func(ctx context.Context, client *base.Client, site string) ([]unifi.WLAN, error) {
+					return client.ListWLAN(ctx, site)
+				},
+				Describe: func(w unifi.WLAN) base.ListItem {
+					return base.ListItem{Name: w.Name, Purpose: w.Security, Enabled: w.Enabled}
+				},
+				Convert: func(_ context.Context, w unifi.WLAN) (wlanListItemModel, diag.Diagnostics) {
+					return wlanListItemModel{
+						ID:          types.StringValue(w.ID),
+						Name:        types.StringValue(w.Name),
+						Security:    types.StringValue(w.Security),
+						NetworkID:   types.StringValue(w.NetworkID),
+						UserGroupID: types.StringValue(w.UserGroupID),
+						WLANBand:    types.StringValue(w.WLANBand),
+						IsGuest:     types.BoolValue(w.IsGuest),
+						HideSSID:    types.BoolValue(w.HideSSID),
+						Enabled:     types.BoolValue(w.Enabled),
+					}, nil
+				},
+			},
+		),
+	}
+}
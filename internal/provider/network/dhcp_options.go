@@ -0,0 +1,260 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+)
+
+// dhcpCustomOptionTypes are the value types of a custom DHCP option, as named
+// by the controller. The width and signedness of an `int` option are set
+// separately, like in go-unifi's unifi.DHCPOption.
+var dhcpCustomOptionTypes = []string{
+	"text",
+	"ipaddress",
+	"macaddress",
+	"boolean",
+	"hexarray",
+	"int",
+}
+
+// dhcpCustomOptionIntWidths are the widths, in bits, of an `int` option.
+var dhcpCustomOptionIntWidths = []int{8, 16, 32}
+
+// dhcpReservedOptionCodes maps the DHCP option codes set through a dedicated
+// attribute of the network to that attribute, so they cannot be set twice.
+var dhcpReservedOptionCodes = map[int]string{
+	1:   "subnet",
+	2:   "dhcpd_time_offset",
+	3:   "dhcpd_gateway",
+	6:   "dhcp_dns",
+	15:  "domain_name",
+	42:  "dhcp_ntp",
+	43:  "dhcpd_unifi_controller",
+	44:  "dhcp_wins",
+	51:  "dhcp_lease",
+	66:  "dhcpd_tftp_server",
+	67:  "dhcpd_boot_filename",
+	252: "dhcpd_wpad_url",
+}
+
+var hexArrayRegex = regexp.MustCompile(`^[0-9A-Fa-f]{2}(:[0-9A-Fa-f]{2})*$`)
+
+// dhcpCustomOption is a custom DHCP option served to the clients of a network.
+type dhcpCustomOption struct {
+	Code   int    `json:"code"`
+	Type   string `json:"type"`
+	Value  string `json:"value"`
+	Width  int    `json:"width,omitempty"`
+	Signed bool   `json:"signed,omitempty"`
+}
+
+// networkDHCPCustomOptions holds the custom DHCP options of a network. They are
+// not part of unifi.Network, so they are read and written separately from the
+// rest of the network; the controller leaves fields not sent unchanged.
+type networkDHCPCustomOptions struct {
+	ID            string             `json:"_id,omitempty"`
+	CustomOptions []dhcpCustomOption `json:"dhcpd_custom_options"`
+}
+
+func dhcpCustomOptionSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Custom DHCP options served to clients of this network, for options without a dedicated attribute. " +
+			"Options with a dedicated attribute, like `dhcp_dns` (6), `dhcp_ntp` (42), `dhcpd_unifi_controller` (43) or `dhcpd_tftp_server` (66), cannot be set here.",
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"code": {
+					Description:  "The DHCP option code, between 1 and 254.",
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 254),
+				},
+				"type": {
+					Description: "The type of the option value. One of `text`, `ipaddress`, `macaddress`, `boolean`, `hexarray` " +
+						"(colon-separated bytes, e.g. `01:0a:ff`) or `int`.",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(dhcpCustomOptionTypes, false),
+				},
+				"value": {
+					Description: "The value of the option, formatted according to `type`, e.g. `true` for a `boolean` or `10.0.0.5` for an `ipaddress`.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"width": {
+					Description:  "The width in bits of an `int` option, one of `8`, `16` or `32`. Required for, and only allowed with, `int` options.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntInSlice(dhcpCustomOptionIntWidths),
+				},
+				"signed": {
+					Description: "Whether an `int` option is signed. Only allowed with `int` options.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+			},
+		},
+	}
+}
+
+// validateDHCPCustomOption checks that an option does not duplicate a dedicated
+// attribute, that only `int` options have a width and signedness, and that its
+// value is valid for its type.
+func validateDHCPCustomOption(option dhcpCustomOption) error {
+	code, optionType, value := option.Code, option.Type, option.Value
+	if attr, ok := dhcpReservedOptionCodes[code]; ok {
+		return fmt.Errorf("DHCP option %d is set with %q, not as a custom option", code, attr)
+	}
+	if optionType == "int" && option.Width == 0 {
+		return fmt.Errorf("DHCP option %d of type int requires a width", code)
+	}
+	if optionType != "int" && (option.Width != 0 || option.Signed) {
+		return fmt.Errorf("DHCP option %d of type %s cannot have a width or be signed, only int options can", code, optionType)
+	}
+	var err error
+	switch optionType {
+	case "text":
+		if value == "" || len(value) > 255 {
+			err = fmt.Errorf("must be between 1 and 255 characters")
+		}
+	case "ipaddress":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+			err = fmt.Errorf("must be an IPv4 address")
+		}
+	case "macaddress":
+		_, err = net.ParseMAC(value)
+	case "boolean":
+		if value != "true" && value != "false" {
+			err = fmt.Errorf("must be true or false")
+		}
+	case "hexarray":
+		if !hexArrayRegex.MatchString(value) {
+			err = fmt.Errorf("must be colon-separated hexadecimal bytes, e.g. 01:0a:ff")
+		}
+	case "int":
+		if option.Signed {
+			_, err = strconv.ParseInt(value, 10, option.Width)
+		} else {
+			_, err = strconv.ParseUint(value, 10, option.Width)
+		}
+	default:
+		err = fmt.Errorf("unsupported type %q", optionType)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid value %q of DHCP option %d of type %s: %w", value, code, optionType, err)
+	}
+	return nil
+}
+
+// customizeNetworkDHCPCustomOptions validates the custom DHCP options at plan
+// time, as the value of an option is only valid relative to its type.
+func customizeNetworkDHCPCustomOptions(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	return validateDHCPCustomOptionsRawConfig(d.GetRawConfig())
+}
+
+// validateDHCPCustomOptionsRawConfig holds the pure raw-config logic so it is
+// unit-testable without constructing a ResourceDiff. Options with an unknown
+// (interpolated) code, type or value are skipped, as they cannot be validated
+// at plan time.
+func validateDHCPCustomOptionsRawConfig(raw cty.Value) error {
+	if raw.IsNull() || !raw.Type().HasAttribute("dhcp_custom_option") {
+		return nil
+	}
+	options := raw.GetAttr("dhcp_custom_option")
+	if options.IsNull() || !options.IsKnown() {
+		return nil
+	}
+	seen := map[int]bool{}
+	for it := options.ElementIterator(); it.Next(); {
+		_, option := it.Element()
+		code, optionType, value := option.GetAttr("code"), option.GetAttr("type"), option.GetAttr("value")
+		if !code.IsKnown() || code.IsNull() {
+			continue
+		}
+		c, _ := code.AsBigFloat().Int64()
+		if seen[int(c)] {
+			return fmt.Errorf("DHCP option %d is set more than once in %q", c, "dhcp_custom_option")
+		}
+		seen[int(c)] = true
+		if !optionType.IsKnown() || optionType.IsNull() || !value.IsKnown() || value.IsNull() {
+			continue
+		}
+		width, signed := option.GetAttr("width"), option.GetAttr("signed")
+		if !width.IsKnown() || !signed.IsKnown() {
+			continue
+		}
+		parsed := dhcpCustomOption{Code: int(c), Type: optionType.AsString(), Value: value.AsString()}
+		if !width.IsNull() {
+			w, _ := width.AsBigFloat().Int64()
+			parsed.Width = int(w)
+		}
+		if !signed.IsNull() {
+			parsed.Signed = signed.True()
+		}
+		if err := validateDHCPCustomOption(parsed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dhcpCustomOptionsFromResourceData(d *schema.ResourceData) []dhcpCustomOption {
+	raw, _ := d.Get("dhcp_custom_option").([]interface{})
+	options := make([]dhcpCustomOption, 0, len(raw))
+	for _, r := range raw {
+		m, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		option := dhcpCustomOption{}
+		option.Code, _ = m["code"].(int)
+		option.Type, _ = m["type"].(string)
+		option.Value, _ = m["value"].(string)
+		option.Width, _ = m["width"].(int)
+		option.Signed, _ = m["signed"].(bool)
+		options = append(options, option)
+	}
+	return options
+}
+
+func flattenDHCPCustomOptions(options []dhcpCustomOption) []interface{} {
+	flattened := make([]interface{}, 0, len(options))
+	for _, o := range options {
+		flattened = append(flattened, map[string]interface{}{
+			"code":   o.Code,
+			"type":   o.Type,
+			"value":  o.Value,
+			"width":  o.Width,
+			"signed": o.Signed,
+		})
+	}
+	return flattened
+}
+
+func readNetworkDHCPCustomOptions(ctx context.Context, c unifi.Client, d *schema.ResourceData, site string) error {
+	resp, err := base.GetRest[networkDHCPCustomOptions](ctx, c, site, "networkconf", d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to read custom DHCP options: %w", err)
+	}
+	return d.Set("dhcp_custom_option", flattenDHCPCustomOptions(resp.CustomOptions))
+}
+
+func updateNetworkDHCPCustomOptions(ctx context.Context, c unifi.Client, d *schema.ResourceData, site string) error {
+	body := &networkDHCPCustomOptions{ID: d.Id(), CustomOptions: dhcpCustomOptionsFromResourceData(d)}
+	if _, err := base.UpdateRest(ctx, c, site, "networkconf", d.Id(), body); err != nil {
+		return fmt.Errorf("unable to update custom DHCP options: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDHCPCustomOption(t *testing.T) {
+	tests := []struct {
+		name    string
+		option  dhcpCustomOption
+		wantErr bool
+	}{
+		{"text", dhcpCustomOption{Code: 150, Type: "text", Value: "pxe"}, false},
+		{"empty text", dhcpCustomOption{Code: 150, Type: "text", Value: ""}, true},
+		{"ipaddress", dhcpCustomOption{Code: 150, Type: "ipaddress", Value: "10.0.0.5"}, false},
+		{"ipv6 ipaddress", dhcpCustomOption{Code: 150, Type: "ipaddress", Value: "2001:db8::1"}, true},
+		{"macaddress", dhcpCustomOption{Code: 150, Type: "macaddress", Value: "00:11:22:33:44:55"}, false},
+		{"invalid macaddress", dhcpCustomOption{Code: 150, Type: "macaddress", Value: "00:11:22"}, true},
+		{"boolean", dhcpCustomOption{Code: 150, Type: "boolean", Value: "true"}, false},
+		{"invalid boolean", dhcpCustomOption{Code: 150, Type: "boolean", Value: "yes"}, true},
+		{"hexarray", dhcpCustomOption{Code: 150, Type: "hexarray", Value: "01:0a:FF"}, false},
+		{"invalid hexarray", dhcpCustomOption{Code: 150, Type: "hexarray", Value: "010aff"}, true},
+		{"signed int8", dhcpCustomOption{Code: 150, Type: "int", Width: 8, Signed: true, Value: "-128"}, false},
+		{"signed int8 overflow", dhcpCustomOption{Code: 150, Type: "int", Width: 8, Signed: true, Value: "128"}, true},
+		{"unsigned int16", dhcpCustomOption{Code: 150, Type: "int", Width: 16, Value: "65535"}, false},
+		{"negative unsigned int16", dhcpCustomOption{Code: 150, Type: "int", Width: 16, Value: "-1"}, true},
+		{"signed int32", dhcpCustomOption{Code: 150, Type: "int", Width: 32, Signed: true, Value: "-2147483648"}, false},
+		{"unsigned int32 overflow", dhcpCustomOption{Code: 150, Type: "int", Width: 32, Value: "4294967296"}, true},
+		{"not a number", dhcpCustomOption{Code: 150, Type: "int", Width: 8, Value: "abc"}, true},
+		{"int without width", dhcpCustomOption{Code: 150, Type: "int", Value: "1"}, true},
+		{"width on text", dhcpCustomOption{Code: 150, Type: "text", Width: 8, Value: "pxe"}, true},
+		{"signed text", dhcpCustomOption{Code: 150, Type: "text", Signed: true, Value: "pxe"}, true},
+		{"reserved tftp server", dhcpCustomOption{Code: 66, Type: "text", Value: "10.0.0.6"}, true},
+		{"reserved ntp", dhcpCustomOption{Code: 42, Type: "ipaddress", Value: "10.0.0.2"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDHCPCustomOption(tt.option)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDHCPCustomOptionsRawConfig(t *testing.T) {
+	optionType := cty.Object(map[string]cty.Type{
+		"code": cty.Number, "type": cty.String, "value": cty.String, "width": cty.Number, "signed": cty.Bool,
+	})
+	option := func(code cty.Value, optionType, value string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"code":   code,
+			"type":   cty.StringVal(optionType),
+			"value":  cty.StringVal(value),
+			"width":  cty.NullVal(cty.Number),
+			"signed": cty.NullVal(cty.Bool),
+		})
+	}
+	intOption := func(width int64, signed bool, value string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"code":   cty.NumberIntVal(160),
+			"type":   cty.StringVal("int"),
+			"value":  cty.StringVal(value),
+			"width":  cty.NumberIntVal(width),
+			"signed": cty.BoolVal(signed),
+		})
+	}
+	rawConfig := func(options cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"dhcp_custom_option": options})
+	}
+
+	tests := []struct {
+		name    string
+		raw     cty.Value
+		wantErr bool
+	}{
+		{"null config", cty.NullVal(cty.Object(map[string]cty.Type{"dhcp_custom_option": cty.List(optionType)})), false},
+		{"no options", rawConfig(cty.NullVal(cty.List(optionType))), false},
+		{"valid options", rawConfig(cty.ListVal([]cty.Value{
+			option(cty.NumberIntVal(150), "ipaddress", "10.0.0.5"),
+			intOption(16, false, "8080"),
+		})), false},
+		{"int overflow", rawConfig(cty.ListVal([]cty.Value{
+			intOption(8, true, "200"),
+		})), true},
+		{"invalid value", rawConfig(cty.ListVal([]cty.Value{
+			option(cty.NumberIntVal(150), "ipaddress", "not-an-ip"),
+		})), true},
+		{"duplicate code", rawConfig(cty.ListVal([]cty.Value{
+			option(cty.NumberIntVal(150), "text", "a"),
+			option(cty.NumberIntVal(150), "text", "b"),
+		})), true},
+		{"unknown code", rawConfig(cty.ListVal([]cty.Value{
+			option(cty.UnknownVal(cty.Number), "ipaddress", "not-an-ip"),
+		})), false},
+		{"unknown value", rawConfig(cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"code":   cty.NumberIntVal(150),
+				"type":   cty.StringVal("ipaddress"),
+				"value":  cty.UnknownVal(cty.String),
+				"width":  cty.NullVal(cty.Number),
+				"signed": cty.NullVal(cty.Bool),
+			}),
+		})), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDHCPCustomOptionsRawConfig(tt.raw)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDHCPCustomOptionsFromResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceNetwork().Schema, map[string]interface{}{
+		"name": "tfacc-dhcp-custom-options",
+		"dhcp_custom_option": []interface{}{
+			map[string]interface{}{"code": 150, "type": "ipaddress", "value": "10.0.0.5"},
+			map[string]interface{}{"code": 160, "type": "int", "value": "-5", "width": 16, "signed": true},
+		},
+	})
+
+	options := dhcpCustomOptionsFromResourceData(d)
+	assert.Equal(t, []dhcpCustomOption{
+		{Code: 150, Type: "ipaddress", Value: "10.0.0.5"},
+		{Code: 160, Type: "int", Value: "-5", Width: 16, Signed: true},
+	}, options)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"code": 150, "type": "ipaddress", "value": "10.0.0.5", "width": 0, "signed": false},
+		map[string]interface{}{"code": 160, "type": "int", "value": "-5", "width": 16, "signed": true},
+	}, flattenDHCPCustomOptions(options))
+}
@@ -0,0 +1,111 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
+)
+
+// lanPurposes are the purposes of networks whose subnet is a LAN address
+// space of the site, which must not overlap with each other.
+var lanPurposes = []string{"corporate", "guest"}
+
+// customizeNetworkIPAM checks the address plan of a LAN at plan time: the DHCP
+// range must be within the subnet, and the subnet must not overlap with the
+// subnet of another LAN of the site. The controller accepts some of these
+// misconfigurations, which then only show up as outages. Values not known at
+// plan time are skipped, and the controller is only asked for the networks of
+// the site when the subnet changes. CustomizeDiff only sees this resource, so
+// overlaps between networks created in the same plan are not detected.
+func customizeNetworkIPAM(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	purpose, _ := d.Get("purpose").(string)
+	subnet, _ := d.Get("subnet").(string)
+	if !slices.Contains(lanPurposes, purpose) || !d.NewValueKnown("subnet") || subnet == "" {
+		return nil
+	}
+
+	if d.NewValueKnown("dhcp_start") && d.NewValueKnown("dhcp_stop") {
+		start, _ := d.Get("dhcp_start").(string)
+		stop, _ := d.Get("dhcp_stop").(string)
+		if err := validateDHCPRange(subnet, start, stop); err != nil {
+			return err
+		}
+	}
+
+	c, ok := meta.(*base.Client)
+	if !ok || c == nil || (d.Id() != "" && !d.HasChange("subnet")) {
+		return nil
+	}
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return err
+	}
+	networks, err := c.ListNetwork(ctx, site)
+	if err != nil {
+		return fmt.Errorf("unable to list networks to check the subnet for overlaps: %w", err)
+	}
+	return validateNetworkSubnetOverlap(d.Id(), subnet, networks)
+}
+
+// validateDHCPRange checks that the DHCP range is within the subnet, is not
+// reversed, and does not include the network, gateway or broadcast address.
+// The gateway is the first host of the subnet, as for every network of this
+// resource. An unset range is not checked.
+func validateDHCPRange(subnet, start, stop string) error {
+	if start == "" || stop == "" {
+		return nil
+	}
+	_, ipNet, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return nil
+	}
+	for _, address := range []string{start, stop} {
+		if inSubnet, err := utils.CidrContainsIP(subnet, address); err != nil || !inSubnet {
+			return fmt.Errorf("DHCP range %s - %s is not within subnet %s", start, stop, ipNet)
+		}
+	}
+	if utils.CompareIPs(start, stop) > 0 {
+		return fmt.Errorf("%q %s must not be after %q %s", "dhcp_start", start, "dhcp_stop", stop)
+	}
+	gateway, _, _ := net.ParseCIDR(utils.CidrOneBased(subnet))
+	reserved := []struct {
+		name string
+		ip   net.IP
+	}{
+		{"network address", ipNet.IP},
+		{"gateway address", gateway},
+		{"broadcast address", utils.CidrBroadcast(subnet)},
+	}
+	for _, r := range reserved {
+		if r.ip != nil && utils.IPInRange(r.ip.String(), start, stop) {
+			return fmt.Errorf("DHCP range %s - %s includes the %s %s of subnet %s", start, stop, r.name, r.ip, ipNet)
+		}
+	}
+	return nil
+}
+
+// validateNetworkSubnetOverlap checks that the subnet does not overlap with
+// the subnet of another LAN of the site. The network with the given ID is the
+// one being planned, so it is skipped.
+func validateNetworkSubnetOverlap(id, subnet string, networks []unifi.Network) error {
+	for _, n := range networks {
+		if n.ID == id || !slices.Contains(lanPurposes, n.Purpose) || n.IPSubnet == "" {
+			continue
+		}
+		overlaps, err := utils.CidrsOverlap(subnet, n.IPSubnet)
+		if err != nil {
+			continue
+		}
+		if overlaps {
+			return fmt.Errorf("subnet %s overlaps with subnet %s of network %q", utils.CidrZeroBased(subnet), utils.CidrZeroBased(n.IPSubnet), n.Name)
+		}
+	}
+	return nil
+}
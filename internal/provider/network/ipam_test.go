@@ -0,0 +1,71 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDHCPRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		subnet  string
+		start   string
+		stop    string
+		wantErr string
+	}{
+		{"valid range", "10.0.0.0/24", "10.0.0.6", "10.0.0.254", ""},
+		{"gateway subnet notation", "10.0.0.1/24", "10.0.0.6", "10.0.0.254", ""},
+		{"unset range", "10.0.0.0/24", "", "", ""},
+		{"start outside subnet", "10.0.0.0/24", "10.0.1.6", "10.0.0.254", "is not within subnet 10.0.0.0/24"},
+		{"stop outside subnet", "10.0.0.0/24", "10.0.0.6", "10.0.1.254", "is not within subnet 10.0.0.0/24"},
+		{"reversed range", "10.0.0.0/24", "10.0.0.200", "10.0.0.100", `"dhcp_start" 10.0.0.200 must not be after "dhcp_stop" 10.0.0.100`},
+		{"includes network address", "10.0.0.0/24", "10.0.0.0", "10.0.0.254", "includes the network address 10.0.0.0"},
+		{"includes gateway address", "10.0.0.0/24", "10.0.0.1", "10.0.0.254", "includes the gateway address 10.0.0.1"},
+		{"includes broadcast address", "10.0.0.0/24", "10.0.0.6", "10.0.0.255", "includes the broadcast address 10.0.0.255"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDHCPRange(tt.subnet, tt.start, tt.stop)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateNetworkSubnetOverlap(t *testing.T) {
+	networks := []unifi.Network{
+		{ID: "lan", Name: "Default", Purpose: "corporate", IPSubnet: "192.168.1.1/24"},
+		{ID: "guest", Name: "Guest", Purpose: "guest", IPSubnet: "10.0.10.1/24"},
+		{ID: "wan", Name: "Internet", Purpose: "wan", IPSubnet: "10.0.20.1/24"},
+		{ID: "vlan", Name: "VLAN only", Purpose: "vlan-only"},
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		subnet  string
+		wantErr string
+	}{
+		{"no overlap", "", "10.0.30.1/24", ""},
+		{"same subnet", "", "192.168.1.1/24", `subnet 192.168.1.0/24 overlaps with subnet 192.168.1.0/24 of network "Default"`},
+		{"containing subnet", "", "10.0.0.1/16", `overlaps with subnet 10.0.10.0/24 of network "Guest"`},
+		{"contained subnet", "", "192.168.1.129/25", `of network "Default"`},
+		{"own subnet", "lan", "192.168.1.1/24", ""},
+		{"wan subnet ignored", "", "10.0.20.1/24", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNetworkSubnetOverlap(tt.id, tt.subnet, networks)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -80,14 +80,18 @@ func ResourceNetwork() *schema.Resource {
 
 		// Cross-field validation the per-attribute schema can't express: a
 		// vpn-client requires its companion fields and rejects wireguard_* on other
-		// purposes, DHCP Guarding requires at least one trusted server, and the
+		// purposes, DHCP Guarding requires at least one trusted server, the
 		// default-gateway override must keep its enable toggle and gateway IP
-		// consistent. Catches misconfigurations at plan time instead of as an opaque
+		// consistent, custom DHCP option values must match their type, and a LAN
+		// must keep its DHCP range within its subnet and not overlap other LANs.
+		// Catches misconfigurations at plan time instead of as an opaque
 		// controller 400.
 		CustomizeDiff: customdiff.All(
 			customizeNetworkVPNClient,
 			customizeNetworkDHCPGuarding,
 			customizeNetworkDefaultGateway,
+			customizeNetworkDHCPCustomOptions,
+			customizeNetworkIPAM,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -97,11 +101,12 @@ func ResourceNetwork() *schema.Resource {
 				Computed:    true,
 			},
 			"site": {
-				Description: "The name of the site to associate the network with.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				ForceNew:    true,
+				Description:      "The name of the site to associate the network with.",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: utils.SiteDiffSuppressFunc,
 			},
 			"name": {
 				Description: "The name of the network. This should be a descriptive name that helps identify the network's purpose, " +
@@ -133,7 +138,11 @@ func ResourceNetwork() *schema.Resource {
 			},
 			"subnet": {
 				Description: "The IPv4 subnet for this network in CIDR notation (e.g., '192.168.1.0/24'). " +
-					"This defines the network's address space and determines the range of IP addresses available for DHCP.",
+					"This defines the network's address space and determines the range of IP addresses available for DHCP. " +
+					"For `corporate` and `guest` networks, the subnet must not overlap the subnet of another such network of the " +
+					"site, and the DHCP range must be within it; this is checked at plan time. Overlaps are only checked against " +
+					"networks that already exist on the controller, so two new networks overlapping each other in the same plan " +
+					"are not detected.",
 				Type:             schema.TypeString,
 				Optional:         true,
 				DiffSuppressFunc: utils.CidrDiffSuppress,
@@ -245,6 +254,53 @@ func ResourceNetwork() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"dhcpd_tftp_server": {
+				Description: "The TFTP server advertised to DHCP clients with DHCP option 66, as an IP address or hostname. " +
+					"Commonly used by VoIP phones to download their configuration.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dhcp_ntp": {
+				Description: "List of IPv4 NTP server addresses to be provided to DHCP clients (DHCP option 42). Maximum 2 servers can be specified.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+			"dhcp_wins": {
+				Description: "List of IPv4 WINS server addresses to be provided to DHCP clients (DHCP option 44). Maximum 2 servers can be specified.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+			"dhcpd_wpad_url": {
+				Description:  "The URL of the proxy auto-configuration file advertised to DHCP clients with DHCP option 252 (WPAD), e.g. `http://proxy.example.com/wpad.dat`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"dhcpd_unifi_controller": {
+				Description: "The IPv4 address of the UniFi controller advertised to DHCP clients with DHCP option 43, " +
+					"so UniFi devices on this network can be adopted by a controller in another network.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"dhcpd_time_offset": {
+				Description: "The offset of the clients' time zone from UTC in seconds, advertised to DHCP clients with DHCP option 2, " +
+					"e.g. `-18000` for UTC-5. The option is not advertised when `0`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-43200, 50400),
+			},
+			"dhcp_custom_option": dhcpCustomOptionSchema(),
 			"dhcpd_gateway_enabled": {
 				Description: "Controls whether the default gateway advertised to this network's DHCP " +
 					"clients is selected automatically or set manually — equivalent to switching the " +
@@ -857,9 +913,9 @@ func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(err)
 	}
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.CreateNetwork(ctx, site, req)
@@ -869,6 +925,12 @@ func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(resp.ID)
 
+	if len(dhcpCustomOptionsFromResourceData(d)) > 0 {
+		if err := updateNetworkDHCPCustomOptions(ctx, c, d, site); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceNetworkSetResourceData(resp, d, site)
 }
 
@@ -884,6 +946,16 @@ func resourceNetworkGetResourceData(d *schema.ResourceData) (*unifi.Network, err
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert dhcp_guarding_trusted_servers to string slice: %w", err)
 	}
+	dhcpNTPRaw, _ := d.Get("dhcp_ntp").([]interface{})
+	dhcpNTP, err := utils.ListToStringSlice(dhcpNTPRaw)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert dhcp_ntp to string slice: %w", err)
+	}
+	dhcpWINSRaw, _ := d.Get("dhcp_wins").([]interface{})
+	dhcpWINS, err := utils.ListToStringSlice(dhcpWINSRaw)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert dhcp_wins to string slice: %w", err)
+	}
 	dhcpV6DNSRaw, _ := d.Get("dhcp_v6_dns").([]interface{})
 	dhcpV6DNS, err := utils.ListToStringSlice(dhcpV6DNSRaw)
 	if err != nil {
@@ -933,6 +1005,14 @@ func resourceNetworkGetResourceData(d *schema.ResourceData) (*unifi.Network, err
 		DHCPDDNS3: append(dhcpDNS, "", "", "")[2],
 		DHCPDDNS4: append(dhcpDNS, "", "", "", "")[3],
 
+		DHCPDNtpEnabled: len(dhcpNTP) > 0,
+		DHCPDNtp1:       append(dhcpNTP, "")[0],
+		DHCPDNtp2:       append(dhcpNTP, "", "")[1],
+
+		DHCPDWinsEnabled: len(dhcpWINS) > 0,
+		DHCPDWins1:       append(dhcpWINS, "")[0],
+		DHCPDWins2:       append(dhcpWINS, "", "")[1],
+
 		VLANEnabled: vlan != 0 && vlan != 1,
 
 		// Same hackish code as for DHCPv4 ¯\_(ツ)_/¯
@@ -961,6 +1041,11 @@ func resourceNetworkGetResourceData(d *schema.ResourceData) (*unifi.Network, err
 	n.DHCPDBootEnabled, _ = d.Get("dhcpd_boot_enabled").(bool)
 	n.DHCPDBootServer, _ = d.Get("dhcpd_boot_server").(string)
 	n.DHCPDBootFilename, _ = d.Get("dhcpd_boot_filename").(string)
+	n.DHCPDTFTPServer, _ = d.Get("dhcpd_tftp_server").(string)
+	n.DHCPDWPAdUrl, _ = d.Get("dhcpd_wpad_url").(string)
+	n.DHCPDUnifiController, _ = d.Get("dhcpd_unifi_controller").(string)
+	n.DHCPDTimeOffset, _ = d.Get("dhcpd_time_offset").(int)
+	n.DHCPDTimeOffsetEnabled = n.DHCPDTimeOffset != 0
 
 	// DHCP default-gateway override (UI "Default Gateway" Auto/Manual). Both
 	// fields lack omitempty so they serialize on every PUT; Optional+Computed
@@ -1273,6 +1358,31 @@ func resourceNetworkSetResourceData(resp *unifi.Network, d *schema.ResourceData,
 		}
 	}
 
+	dhcpNTP := []string{}
+	if resp.DHCPDNtpEnabled {
+		for _, ntp := range []string{resp.DHCPDNtp1, resp.DHCPDNtp2} {
+			if ntp == "" {
+				continue
+			}
+			dhcpNTP = append(dhcpNTP, ntp)
+		}
+	}
+
+	dhcpWINS := []string{}
+	if resp.DHCPDWinsEnabled {
+		for _, wins := range []string{resp.DHCPDWins1, resp.DHCPDWins2} {
+			if wins == "" {
+				continue
+			}
+			dhcpWINS = append(dhcpWINS, wins)
+		}
+	}
+
+	dhcpTimeOffset := 0
+	if resp.DHCPDTimeOffsetEnabled {
+		dhcpTimeOffset = resp.DHCPDTimeOffset
+	}
+
 	dhcpGuardServers := []string{}
 	for _, ip := range []string{
 		resp.DHCPDIP1,
@@ -1354,6 +1464,12 @@ func resourceNetworkSetResourceData(resp *unifi.Network, d *schema.ResourceData,
 		"dhcpd_boot_server":             resp.DHCPDBootServer,
 		"dhcpd_gateway_enabled":         resp.DHCPDGatewayEnabled,
 		"dhcpd_gateway":                 resp.DHCPDGateway,
+		"dhcp_ntp":                      dhcpNTP,
+		"dhcp_wins":                     dhcpWINS,
+		"dhcpd_tftp_server":             resp.DHCPDTFTPServer,
+		"dhcpd_wpad_url":                resp.DHCPDWPAdUrl,
+		"dhcpd_unifi_controller":        resp.DHCPDUnifiController,
+		"dhcpd_time_offset":             dhcpTimeOffset,
 		"domain_name":                   resp.DomainName,
 		"enabled":                       resp.Enabled,
 		"igmp_snooping":                 resp.IGMPSnooping,
@@ -1427,9 +1543,9 @@ func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.GetNetwork(ctx, site, id)
@@ -1440,6 +1556,9 @@ func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, meta inter
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := readNetworkDHCPCustomOptions(ctx, c, d, site); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return resourceNetworkSetResourceData(resp, d, site)
 }
@@ -1456,9 +1575,9 @@ func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	req.ID = d.Id()
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	req.SiteID = site
 
@@ -1478,6 +1597,11 @@ func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		d.SetId("")
 		return nil
 	}
+	if d.HasChange("dhcp_custom_option") {
+		if err := updateNetworkDHCPCustomOptions(ctx, c, d, site); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	return resourceNetworkSetResourceData(resp, d, site)
 }
@@ -1488,13 +1612,13 @@ func resourceNetworkDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf("unexpected meta type: %T", meta)
 	}
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
-	err := c.DeleteNetwork(ctx, site, id)
+	err = c.DeleteNetwork(ctx, site, id)
 	// Treat an already-deleted network as success. A GET of a missing network
 	// yields ErrNotFound (404), but DELETE of one already removed out-of-band
 	// returns a 400 "api.err.IdInvalid" instead, so match both.
@@ -1510,14 +1634,16 @@ func importNetwork(ctx context.Context, d *schema.ResourceData, meta interface{}
 		return nil, fmt.Errorf("unexpected meta type: %T", meta)
 	}
 	id := d.Id()
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return nil, err
 	}
 
 	if strings.Contains(id, ":") {
 		importParts := strings.SplitN(id, ":", 2)
-		site = importParts[0]
+		if site, err = c.ResolveSiteName(importParts[0]); err != nil {
+			return nil, err
+		}
 		id = importParts[1]
 	}
 
@@ -0,0 +1,298 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/validators"
+)
+
+const (
+	defaultAllocationVLANMin = 2
+	defaultAllocationVLANMax = 4009
+)
+
+// allocationRegistry holds the VLAN IDs and subnets allocated per site during
+// this run of the provider. Allocations created in the same run are usually
+// used by networks which do not exist on the controller yet, so they must be
+// reserved here to not be allocated twice.
+type allocationRegistry struct {
+	mu      sync.Mutex
+	vlans   map[string]map[int]bool
+	subnets map[string][]string
+}
+
+func newAllocationRegistry() *allocationRegistry {
+	return &allocationRegistry{
+		vlans:   map[string]map[int]bool{},
+		subnets: map[string][]string{},
+	}
+}
+
+var allocations = newAllocationRegistry()
+
+// allocate picks the first VLAN ID of the range and the first subnet of the
+// supernet used neither by the networks of the site nor by other allocations
+// of this run, and reserves them.
+func (r *allocationRegistry) allocate(site string, networks []unifi.Network, vlanMin, vlanMax int, supernet string, prefixLength int) (int, string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	usedVLANs := map[int]bool{}
+	for vlan := range r.vlans[site] {
+		usedVLANs[vlan] = true
+	}
+	usedSubnets := append([]string{}, r.subnets[site]...)
+	for _, n := range networks {
+		if n.VLANEnabled {
+			usedVLANs[n.VLAN] = true
+		}
+		if n.IPSubnet != "" {
+			usedSubnets = append(usedSubnets, n.IPSubnet)
+		}
+	}
+
+	vlan := 0
+	for candidate := vlanMin; candidate <= vlanMax; candidate++ {
+		if !usedVLANs[candidate] {
+			vlan = candidate
+			break
+		}
+	}
+	if vlan == 0 {
+		return 0, "", fmt.Errorf("no free VLAN ID left between %d and %d", vlanMin, vlanMax)
+	}
+	subnet, err := utils.NextFreeSubnet(supernet, prefixLength, usedSubnets)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if r.vlans[site] == nil {
+		r.vlans[site] = map[int]bool{}
+	}
+	r.vlans[site][vlan] = true
+	r.subnets[site] = append(r.subnets[site], subnet)
+	return vlan, subnet, nil
+}
+
+// networkAllocationModel represents a VLAN ID and subnet allocated for a new
+// network. The ID of the resource is the allocated subnet.
+type networkAllocationModel struct {
+	base.Model
+	VLANIDMin    types.Int64  `tfsdk:"vlan_id_min"`
+	VLANIDMax    types.Int64  `tfsdk:"vlan_id_max"`
+	Supernet     types.String `tfsdk:"supernet"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+	VLANID       types.Int64  `tfsdk:"vlan_id"`
+	Subnet       types.String `tfsdk:"subnet"`
+	Gateway      types.String `tfsdk:"gateway"`
+}
+
+// AsUnifiModel is not used, as the allocation is not stored on the controller.
+func (m *networkAllocationModel) AsUnifiModel(_ context.Context) (interface{}, diag.Diagnostics) {
+	return nil, nil
+}
+
+// Merge is not used, as the allocation is not stored on the controller.
+func (m *networkAllocationModel) Merge(_ context.Context, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+var (
+	_ base.ResourceModel                  = &networkAllocationModel{}
+	_ resource.Resource                   = &networkAllocationResource{}
+	_ resource.ResourceWithConfigure      = &networkAllocationResource{}
+	_ resource.ResourceWithValidateConfig = &networkAllocationResource{}
+)
+
+type networkAllocationResource struct {
+	*base.GenericResource[*networkAllocationModel]
+}
+
+// NewNetworkAllocationResource creates a new instance of the network
+// allocation resource. It embeds GenericResource to inherit the shared
+// Configure/Metadata/client infrastructure; all CRUD is overridden below, as
+// the allocation only lives in the state.
+func NewNetworkAllocationResource() resource.Resource {
+	return &networkAllocationResource{
+		GenericResource: base.NewGenericResource(
+			"unifi_network_allocation",
+			func() *networkAllocationModel { return &networkAllocationModel{} },
+			base.ResourceFunctions{},
+		),
+	}
+}
+
+func (r *networkAllocationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	vlanRange := func(desc string, def int64) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: desc + " Changing it allocates a new VLAN ID and subnet.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(def),
+			Validators: []validator.Int64{
+				int64validator.Between(2, 4094),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		}
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_network_allocation` resource allocates a free VLAN ID and a free subnet for a new network, " +
+			"based on the networks already on the site, to be used with `unifi_network`.\n\n" +
+			"The allocation is made once, when the resource is created, and kept in the state afterwards, " +
+			"so it does not change when networks are added or removed. It is only stored in the state: " +
+			"VLAN IDs and subnets are reserved for other allocations of the same run, but not for allocations whose network was never created.",
+		Attributes: map[string]schema.Attribute{
+			"id":          ut.ID("The allocated subnet."),
+			"site":        ut.SiteAttribute(),
+			"vlan_id_min": vlanRange("The lowest VLAN ID to allocate. Defaults to `2`.", defaultAllocationVLANMin),
+			"vlan_id_max": vlanRange("The highest VLAN ID to allocate. Defaults to `4009`.", defaultAllocationVLANMax),
+			"supernet": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 CIDR to allocate the subnet from, e.g. `10.20.0.0/16`. Changing it allocates a new VLAN ID and subnet.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.CIDR(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prefix_length": schema.Int64Attribute{
+				MarkdownDescription: "The prefix length of the subnet to allocate, e.g. `24`. Changing it allocates a new VLAN ID and subnet.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(8, 30),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"vlan_id": schema.Int64Attribute{
+				MarkdownDescription: "The allocated VLAN ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"subnet": schema.StringAttribute{
+				MarkdownDescription: "The allocated subnet, e.g. `10.20.3.0/24`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "The first address of the allocated subnet, used by the UniFi gateway for the network, e.g. `10.20.3.1`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects empty VLAN ranges and prefix lengths shorter than the
+// prefix length of the supernet.
+func (r *networkAllocationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config networkAllocationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if ut.IsDefined(config.VLANIDMin) && ut.IsDefined(config.VLANIDMax) && config.VLANIDMin.ValueInt64() > config.VLANIDMax.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(path.Root("vlan_id_max"), "Invalid VLAN ID range",
+			fmt.Sprintf("`vlan_id_max` %d must not be lower than `vlan_id_min` %d.", config.VLANIDMax.ValueInt64(), config.VLANIDMin.ValueInt64()))
+	}
+	if ut.IsDefined(config.Supernet) && ut.IsDefined(config.PrefixLength) {
+		if _, superNet, err := net.ParseCIDR(config.Supernet.ValueString()); err == nil {
+			if ones, _ := superNet.Mask.Size(); config.PrefixLength.ValueInt64() < int64(ones) {
+				resp.Diagnostics.AddAttributeError(path.Root("prefix_length"), "Invalid prefix length",
+					fmt.Sprintf("`prefix_length` %d must not be shorter than the prefix length of `supernet` %s.", config.PrefixLength.ValueInt64(), superNet))
+			}
+		}
+	}
+}
+
+func (r *networkAllocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "Expected configured client. Please report this issue to the provider developers.")
+		return
+	}
+	var model networkAllocationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	site, diags := r.GetClient().ResolveSite(&model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networks, err := r.GetClient().ListNetwork(ctx, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing networks", err.Error())
+		return
+	}
+	vlan, subnet, err := allocations.allocate(site, networks,
+		int(model.VLANIDMin.ValueInt64()), int(model.VLANIDMax.ValueInt64()),
+		model.Supernet.ValueString(), int(model.PrefixLength.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error allocating network", err.Error())
+		return
+	}
+	gateway, _, _ := net.ParseCIDR(utils.CidrOneBased(subnet))
+
+	model.SetID(subnet)
+	model.SetSite(site)
+	model.VLANID = types.Int64Value(int64(vlan))
+	model.Subnet = types.StringValue(subnet)
+	model.Gateway = types.StringValue(gateway.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Read keeps the allocation, as it is only stored in the state.
+func (r *networkAllocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model networkAllocationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Update is never called with a new allocation, as changing any of its inputs
+// replaces the resource.
+func (r *networkAllocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model networkAllocationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete releases the allocation by removing it from the state.
+func (r *networkAllocationResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState is not supported, as the inputs of an allocation cannot be
+// derived from its network.
+func (r *networkAllocationResource) ImportState(_ context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.AddError("Import not supported", "The `unifi_network_allocation` resource cannot be imported. Create it instead, or set `vlan_id` and `subnet` of the network directly.")
+}
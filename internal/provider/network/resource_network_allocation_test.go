@@ -0,0 +1,61 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocationRegistry_allocate(t *testing.T) {
+	networks := []unifi.Network{
+		{Name: "Default", Purpose: "corporate", IPSubnet: "192.168.1.1/24"},
+		{Name: "tenant-a", Purpose: "corporate", VLANEnabled: true, VLAN: 100, IPSubnet: "10.20.0.1/24"},
+		{Name: "tenant-b", Purpose: "corporate", VLANEnabled: true, VLAN: 102, IPSubnet: "10.20.2.1/24"},
+		{Name: "disabled-vlan", Purpose: "corporate", VLAN: 101},
+	}
+
+	t.Run("picks the first free VLAN ID and subnet", func(t *testing.T) {
+		r := newAllocationRegistry()
+		vlan, subnet, err := r.allocate("default", networks, 100, 200, "10.20.0.0/16", 24)
+		require.NoError(t, err)
+		assert.Equal(t, 101, vlan)
+		assert.Equal(t, "10.20.1.0/24", subnet)
+	})
+
+	t.Run("does not allocate twice in the same run", func(t *testing.T) {
+		r := newAllocationRegistry()
+		_, _, err := r.allocate("default", networks, 100, 200, "10.20.0.0/16", 24)
+		require.NoError(t, err)
+		vlan, subnet, err := r.allocate("default", networks, 100, 200, "10.20.0.0/16", 24)
+		require.NoError(t, err)
+		assert.Equal(t, 103, vlan)
+		assert.Equal(t, "10.20.3.0/24", subnet)
+	})
+
+	t.Run("keeps sites apart", func(t *testing.T) {
+		r := newAllocationRegistry()
+		_, _, err := r.allocate("default", nil, 100, 200, "10.20.0.0/16", 24)
+		require.NoError(t, err)
+		vlan, subnet, err := r.allocate("other", nil, 100, 200, "10.20.0.0/16", 24)
+		require.NoError(t, err)
+		assert.Equal(t, 100, vlan)
+		assert.Equal(t, "10.20.0.0/24", subnet)
+	})
+
+	t.Run("fails without a free VLAN ID", func(t *testing.T) {
+		r := newAllocationRegistry()
+		_, _, err := r.allocate("default", networks, 100, 100, "10.20.0.0/16", 24)
+		assert.EqualError(t, err, "no free VLAN ID left between 100 and 100")
+	})
+
+	t.Run("fails without a free subnet", func(t *testing.T) {
+		r := newAllocationRegistry()
+		_, _, err := r.allocate("default", networks, 100, 200, "10.20.0.0/24", 24)
+		assert.EqualError(t, err, "no free /24 subnet left in 10.20.0.0/24")
+		vlan, _, err := r.allocate("default", networks, 100, 200, "10.20.0.0/22", 24)
+		require.NoError(t, err)
+		assert.Equal(t, 101, vlan, "a failed allocation must not reserve a VLAN ID")
+	})
+}
@@ -322,3 +322,74 @@ func TestResourceNetworkSetResourceData_defaultGateway(t *testing.T) {
 		t.Fatalf("expected dhcpd_gateway to be %q in state, got %q", "10.0.0.1", got)
 	}
 }
+
+// TestResourceNetworkGetResourceData_dhcpOptions guards the write path of the
+// advanced DHCP server options: lists fan out to their positional fields and
+// enable the option, and a zero time offset leaves option 2 disabled.
+func TestResourceNetworkGetResourceData_dhcpOptions(t *testing.T) {
+	raw := map[string]interface{}{
+		"name":                   "tfacc-dhcp-options",
+		"purpose":                "corporate",
+		"dhcp_ntp":               []interface{}{"10.0.0.2", "10.0.0.3"},
+		"dhcp_wins":              []interface{}{"10.0.0.4"},
+		"dhcpd_tftp_server":      "tftp.example.com",
+		"dhcpd_wpad_url":         "http://proxy.example.com/wpad.dat",
+		"dhcpd_unifi_controller": "10.0.0.5",
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceNetwork().Schema, raw)
+
+	req, err := resourceNetworkGetResourceData(d)
+	if err != nil {
+		t.Fatalf("resourceNetworkGetResourceData returned error: %s", err)
+	}
+	if !req.DHCPDNtpEnabled || req.DHCPDNtp1 != "10.0.0.2" || req.DHCPDNtp2 != "10.0.0.3" {
+		t.Errorf("unexpected NTP fields: enabled=%v, 1=%q, 2=%q", req.DHCPDNtpEnabled, req.DHCPDNtp1, req.DHCPDNtp2)
+	}
+	if !req.DHCPDWinsEnabled || req.DHCPDWins1 != "10.0.0.4" || req.DHCPDWins2 != "" {
+		t.Errorf("unexpected WINS fields: enabled=%v, 1=%q, 2=%q", req.DHCPDWinsEnabled, req.DHCPDWins1, req.DHCPDWins2)
+	}
+	if req.DHCPDTFTPServer != "tftp.example.com" {
+		t.Errorf("DHCPDTFTPServer = %q, want %q", req.DHCPDTFTPServer, "tftp.example.com")
+	}
+	if req.DHCPDWPAdUrl != "http://proxy.example.com/wpad.dat" {
+		t.Errorf("DHCPDWPAdUrl = %q, want %q", req.DHCPDWPAdUrl, "http://proxy.example.com/wpad.dat")
+	}
+	if req.DHCPDUnifiController != "10.0.0.5" {
+		t.Errorf("DHCPDUnifiController = %q, want %q", req.DHCPDUnifiController, "10.0.0.5")
+	}
+	if req.DHCPDTimeOffsetEnabled {
+		t.Errorf("expected DHCPDTimeOffsetEnabled to be false without a time offset")
+	}
+}
+
+// TestResourceNetworkSetResourceData_dhcpOptions is the symmetric read-path guard:
+// disabled options read back empty, whatever stale values the controller keeps.
+func TestResourceNetworkSetResourceData_dhcpOptions(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceNetwork().Schema, map[string]interface{}{})
+
+	resp := &unifi.Network{
+		DHCPDNtpEnabled:        true,
+		DHCPDNtp1:              "10.0.0.2",
+		DHCPDWinsEnabled:       false,
+		DHCPDWins1:             "10.0.0.4",
+		DHCPDTimeOffsetEnabled: true,
+		DHCPDTimeOffset:        -18000,
+		DHCPDTFTPServer:        "10.0.0.6",
+	}
+	if diags := resourceNetworkSetResourceData(resp, d, "default"); diags.HasError() {
+		t.Fatalf("resourceNetworkSetResourceData returned diagnostics: %v", diags)
+	}
+	if got, _ := d.Get("dhcp_ntp").([]interface{}); len(got) != 1 || got[0] != "10.0.0.2" {
+		t.Errorf("dhcp_ntp = %v, want [10.0.0.2]", got)
+	}
+	if got, _ := d.Get("dhcp_wins").([]interface{}); len(got) != 0 {
+		t.Errorf("dhcp_wins = %v, want empty", got)
+	}
+	if got, _ := d.Get("dhcpd_time_offset").(int); got != -18000 {
+		t.Errorf("dhcpd_time_offset = %d, want %d", got, -18000)
+	}
+	if got, _ := d.Get("dhcpd_tftp_server").(string); got != "10.0.0.6" {
+		t.Errorf("dhcpd_tftp_server = %q, want %q", got, "10.0.0.6")
+	}
+}
@@ -8,7 +8,9 @@
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
 
 	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -20,14 +22,25 @@
 	wlanValidMinimumDataRate5g = []int{6000, 9000, 12000, 18000, 24000, 36000, 48000, 54000}
 )
 
+// Default DTIM periods of the controller, per band.
+const (
+	wlanDefaultDTIM2g = 1
+	wlanDefaultDTIM5g = 3
+	wlanDefaultDTIM6g = 3
+)
+
 func ResourceWLAN() *schema.Resource {
 	return &schema.Resource{
 		Description: "The `unifi_wlan` resource manages wireless networks (SSIDs) on UniFi access points.\n\n" +
 			"This resource allows you to create and manage WiFi networks with various security options including WPA2, WPA3, " +
-			"and enterprise authentication. You can configure features such as guest policies, minimum data rates, band steering, " +
-			"and scheduled availability.\n\n" +
+			"and enterprise authentication. You can configure features such as guest policies, minimum data rates, per-band DTIM " +
+			"periods, and scheduled availability.\n\n" +
 			"Each WLAN can be customized with different security settings, VLAN assignments, and client options to meet specific " +
-			"networking requirements.",
+			"networking requirements.\n\n" +
+			"Some radio settings are not per WLAN on the controller and are therefore configured elsewhere: band steering " +
+			"(`band_steering_mode`), minimum RSSI (`min_rssi` and `min_rssi_enabled` of each `radio` block) and per-AP WLAN overrides " +
+			"(`wlan_override`) are set on `unifi_device`, per-SSID rate limits through the `unifi_user_group` referenced by " +
+			"`user_group_id`, and 6GHz is enabled by adding `6g` to `wlan_bands`.",
 
 		CreateContext: resourceWLANCreate,
 		ReadContext:   resourceWLANRead,
@@ -37,6 +50,15 @@ func ResourceWLAN() *schema.Resource {
 			StateContext: base.ImportSiteAndID,
 		},
 
+		// Private pre-shared keys are validated at plan time: every key must be
+		// unique (the controller can only map a passphrase to one network) and every
+		// referenced network must exist, otherwise the controller silently drops the
+		// key or rejects the whole WLAN with an opaque error.
+		CustomizeDiff: customdiff.All(
+			customizeWLANPrivatePresharedKeys,
+			customizeWLANPrivatePresharedKeyNetworks,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description: "The unique identifier of the wireless network in the UniFi controller.",
@@ -44,11 +66,12 @@ func ResourceWLAN() *schema.Resource {
 				Computed:    true,
 			},
 			"site": {
-				Description: "The name of the UniFi site where the wireless network should be created. If not specified, the default site will be used.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				ForceNew:    true,
+				Description:      "The name of the UniFi site where the wireless network should be created. If not specified, the default site will be used.",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: utils.SiteDiffSuppressFunc,
 			},
 			"name": {
 				Description:  "The SSID (network name) that will be broadcast by the access points. Must be between 1 and 32 characters long.",
@@ -98,6 +121,30 @@ func ResourceWLAN() *schema.Resource {
 				Optional:  true,
 				Sensitive: true,
 			},
+			"private_preshared_keys": {
+				Description: "Private pre-shared keys (PPSK) for the network. Each key is a separate passphrase that places clients " +
+					"authenticating with it on the given network (VLAN), allowing a single SSID to serve multiple network segments. " +
+					"Requires security to be set to `wpapsk`. Passphrases must be unique within the WLAN and every `network_id` " +
+					"must reference an existing network.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"passphrase": {
+							Description:  "The WPA pre-shared key for this private key. Must be between 8 and 63 characters long.",
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(8, 63),
+						},
+						"network_id": {
+							Description: "ID of the network (VLAN) clients using this passphrase are placed on.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
 			"hide_ssid": {
 				Description: "When enabled, the access points will not broadcast the network name (SSID). Clients will need to manually enter the SSID to connect.",
 				Type:        schema.TypeBool,
@@ -142,6 +189,12 @@ func ResourceWLAN() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"hotspot2conf_id": {
+				Description: "ID of the Hotspot 2.0 (Passpoint) profile to advertise on this WLAN. Manage profiles with the " +
+					"`unifi_hotspot2_profile` resource. Requires security to be set to `wpaeap`.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"schedule": {
 				Description: "Time-based access control configuration for the wireless network. Allows automatic enabling/disabling of the network on specified schedules.",
 				Type:        schema.TypeList,
@@ -237,6 +290,44 @@ func ResourceWLAN() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntInSlice(append([]int{0}, wlanValidMinimumDataRate5g...)),
 			},
+			"dtim_2g": {
+				Description: "DTIM (Delivery Traffic Indication Message) period for the 2.4GHz radio, in beacon intervals. " +
+					"Higher values save client battery at the cost of multicast/broadcast latency. When none of `dtim_2g`, " +
+					"`dtim_5g` and `dtim_6g` is set, the controller's default DTIM periods apply. When only some of them are set, " +
+					"the others use the controller's default periods: 1 for 2.4GHz and 3 for 5GHz and 6GHz.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 255),
+			},
+			"dtim_5g": {
+				Description:  "DTIM period for the 5GHz radio, in beacon intervals. See `dtim_2g`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 255),
+			},
+			"dtim_6g": {
+				Description:  "DTIM period for the 6GHz radio, in beacon intervals. See `dtim_2g`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 255),
+			},
+			"broadcast_filter_enabled": {
+				Description: "Enable broadcast and multicast filtering. When enabled, only broadcast/multicast traffic from " +
+					"the MAC addresses in `broadcast_filter_list` (typically the gateway) is forwarded to wireless clients.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"broadcast_filter_list": {
+				Description: "MAC addresses in XX:XX:XX:XX:XX:XX format whose broadcast/multicast traffic is still forwarded " +
+					"when `broadcast_filter_enabled` is true.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateFunc:     validation.StringMatch(utils.MacAddressRegexp, "Mac address is invalid"),
+					DiffSuppressFunc: utils.MacDiffSuppressFunc,
+				},
+			},
 			"wlan_band": {
 				Description: "Radio band selection (legacy single-band field). Valid values:\n" +
 					"  * `both` - Both 2.4GHz and 5GHz\n" +
@@ -318,6 +409,20 @@ func resourceWLANGetResourceData(d *schema.ResourceData, meta interface{}) (*uni
 		return nil, errors.New("for WPA 3 you must set pmf_mode to required")
 	}
 
+	ppskSet, _ := d.Get("private_preshared_keys").(*schema.Set)
+	ppsk, err := setToPrivatePresharedKeys(ppskSet)
+	if err != nil {
+		return nil, fmt.Errorf("unable to process private_preshared_keys block: %w", err)
+	}
+	if len(ppsk) > 0 && security != "wpapsk" {
+		return nil, errors.New("private_preshared_keys are only valid for security type wpapsk")
+	}
+
+	hotspot2ConfID, _ := d.Get("hotspot2conf_id").(string)
+	if hotspot2ConfID != "" && security != "wpaeap" {
+		return nil, errors.New("hotspot2conf_id is only valid for security type wpaeap")
+	}
+
 	macFilterEnabled, _ := d.Get("mac_filter_enabled").(bool)
 	macFilterListSet, _ := d.Get("mac_filter_list").(*schema.Set)
 	macFilterList, err := utils.SetToStringSlice(macFilterListSet)
@@ -328,6 +433,29 @@ func resourceWLANGetResourceData(d *schema.ResourceData, meta interface{}) (*uni
 		macFilterList = nil
 	}
 
+	broadcastFilterEnabled, _ := d.Get("broadcast_filter_enabled").(bool)
+	broadcastFilterListSet, _ := d.Get("broadcast_filter_list").(*schema.Set)
+	broadcastFilterList, err := utils.SetToStringSlice(broadcastFilterListSet)
+	if err != nil {
+		return nil, err
+	}
+	if !broadcastFilterEnabled {
+		broadcastFilterList = nil
+	}
+
+	dtim2g, _ := d.Get("dtim_2g").(int)
+	dtim5g, _ := d.Get("dtim_5g").(int)
+	dtim6g, _ := d.Get("dtim_6g").(int)
+	dtimMode := "default"
+	if dtim2g != 0 || dtim5g != 0 || dtim6g != 0 {
+		// Custom DTIM periods apply to all bands, so bands not set are sent
+		// with the controller's default period instead of an invalid 0.
+		dtimMode = "custom"
+		dtim2g = valueOrDefault(dtim2g, wlanDefaultDTIM2g)
+		dtim5g = valueOrDefault(dtim5g, wlanDefaultDTIM5g)
+		dtim6g = valueOrDefault(dtim6g, wlanDefaultDTIM6g)
+	}
+
 	// version specific fields and validation
 	networkID, _ := d.Get("network_id").(string)
 	apGroupIDsSet, _ := d.Get("ap_group_ids").(*schema.Set)
@@ -424,6 +552,15 @@ func resourceWLANGetResourceData(d *schema.ResourceData, meta interface{}) (*uni
 		SettingPreference:       settingPreference,
 		PMFMode:                 pmf,
 
+		BroadcastFilterEnabled: broadcastFilterEnabled,
+		BroadcastFilterList:    broadcastFilterList,
+
+		PrivatePresharedKeys:        ppsk,
+		PrivatePresharedKeysEnabled: len(ppsk) > 0,
+
+		Hotspot2ConfEnabled: hotspot2ConfID != "",
+		Hotspot2ConfID:      hotspot2ConfID,
+
 		// TODO: add to schema
 		WPAEnc:             "ccmp",
 		WPAMode:            "wpa2",
@@ -431,7 +568,10 @@ func resourceWLANGetResourceData(d *schema.ResourceData, meta interface{}) (*uni
 		NameCombineEnabled: true,
 
 		GroupRekey:         3600,
-		DTIMMode:           "default",
+		DTIMMode:           dtimMode,
+		DTIMNg:             dtim2g,
+		DTIMNa:             dtim5g,
+		DTIM6E:             dtim6g,
 		No2GhzOui:          no2ghzOui,
 		L2Isolation:        l2Isolation,
 		ProxyArp:           proxyArp,
@@ -460,9 +600,9 @@ func resourceWLANCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.FromErr(err)
 	}
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.CreateWLAN(ctx, site, req)
@@ -496,10 +636,32 @@ func resourceWLANSetResourceData(resp *unifi.WLAN, d *schema.ResourceData, site
 		macFilterPolicy = resp.MACFilterPolicy
 	}
 
+	var broadcastFilterList *schema.Set
+	if resp.BroadcastFilterEnabled {
+		broadcastFilterList = utils.StringSliceToSet(resp.BroadcastFilterList)
+	}
+
+	dtim2g, dtim5g, dtim6g := 0, 0, 0
+	if resp.DTIMMode == "custom" {
+		dtim2g = dtimFromResponse(d, "dtim_2g", resp.DTIMNg, wlanDefaultDTIM2g)
+		dtim5g = dtimFromResponse(d, "dtim_5g", resp.DTIMNa, wlanDefaultDTIM5g)
+		dtim6g = dtimFromResponse(d, "dtim_6g", resp.DTIM6E, wlanDefaultDTIM6g)
+	}
+
 	apGroupIDs := utils.StringSliceToSet(resp.ApGroupIDs)
 
+	var ppsk []interface{}
+	if resp.PrivatePresharedKeysEnabled {
+		ppsk = listFromPrivatePresharedKeys(resp.PrivatePresharedKeys)
+	}
+
 	schedule := listFromSchedules(resp.ScheduleWithDuration)
 
+	hotspot2ConfID := ""
+	if resp.Hotspot2ConfEnabled {
+		hotspot2ConfID = resp.Hotspot2ConfID
+	}
+
 	minRate2g := 0
 	if resp.MinrateSettingPreference != "auto" && resp.MinrateNgEnabled {
 		minRate2g = resp.MinrateNgDataRateKbps
@@ -514,6 +676,7 @@ func resourceWLANSetResourceData(resp *unifi.WLAN, d *schema.ResourceData, site
 		"name":                      resp.Name,
 		"user_group_id":             resp.UserGroupID,
 		"passphrase":                passphrase,
+		"private_preshared_keys":    ppsk,
 		"hide_ssid":                 resp.HideSSID,
 		"is_guest":                  resp.IsGuest,
 		"security":                  security,
@@ -523,7 +686,13 @@ func resourceWLANSetResourceData(resp *unifi.WLAN, d *schema.ResourceData, site
 		"mac_filter_enabled":        macFilterEnabled,
 		"mac_filter_list":           macFilterList,
 		"mac_filter_policy":         macFilterPolicy,
+		"broadcast_filter_enabled":  resp.BroadcastFilterEnabled,
+		"broadcast_filter_list":     broadcastFilterList,
+		"dtim_2g":                   dtim2g,
+		"dtim_5g":                   dtim5g,
+		"dtim_6g":                   dtim6g,
 		"radius_profile_id":         resp.RADIUSProfileID,
+		"hotspot2conf_id":           hotspot2ConfID,
 		"schedule":                  schedule,
 		"wlan_band":                 resp.WLANBand,
 		"wlan_bands":                utils.StringSliceToSet(resp.WLANBands),
@@ -554,9 +723,9 @@ func resourceWLANRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	}
 
 	id := d.Id()
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.GetWLAN(ctx, site, id)
@@ -583,9 +752,9 @@ func resourceWLANUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	req.ID = d.Id()
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	req.SiteID = site
 
@@ -614,18 +783,36 @@ func resourceWLANDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	id := d.Id()
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	err := c.DeleteWLAN(ctx, site, id)
+	err = c.DeleteWLAN(ctx, site, id)
 	if errors.Is(err, unifi.ErrNotFound) {
 		return nil
 	}
 	return diag.FromErr(err)
 }
 
+// valueOrDefault returns the value, or the default when it is not set.
+func valueOrDefault(value, def int) int {
+	if value == 0 {
+		return def
+	}
+	return value
+}
+
+// dtimFromResponse returns the DTIM period of a band to store in state. A band
+// not set in state that has the default period was filled in when sending
+// custom periods of other bands, so it is kept unset to not diff.
+func dtimFromResponse(d *schema.ResourceData, key string, value, def int) int {
+	if current, _ := d.Get(key).(int); current == 0 && value == def {
+		return 0
+	}
+	return value
+}
+
 func listToSchedules(list []interface{}) ([]unifi.WLANScheduleWithDuration, error) {
 	schedules := make([]unifi.WLANScheduleWithDuration, 0, len(list))
 	for _, item := range list {
@@ -677,3 +864,132 @@ func listFromSchedules(ss []unifi.WLANScheduleWithDuration) []interface{} {
 	}
 	return list
 }
+
+func setToPrivatePresharedKeys(set *schema.Set) ([]unifi.WLANPrivatePresharedKeys, error) {
+	if set == nil {
+		return nil, nil
+	}
+	keys := make([]unifi.WLANPrivatePresharedKeys, 0, set.Len())
+	for _, item := range set.List() {
+		data, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("unexpected data in block")
+		}
+		passphrase, _ := data["passphrase"].(string)
+		networkID, _ := data["network_id"].(string)
+		keys = append(keys, unifi.WLANPrivatePresharedKeys{
+			Password:  passphrase,
+			NetworkID: networkID,
+		})
+	}
+	return keys, nil
+}
+
+func listFromPrivatePresharedKeys(keys []unifi.WLANPrivatePresharedKeys) []interface{} {
+	list := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		list = append(list, map[string]interface{}{
+			"passphrase": k.Password,
+			"network_id": k.NetworkID,
+		})
+	}
+	return list
+}
+
+// customizeWLANPrivatePresharedKeys rejects duplicate private pre-shared keys and
+// keys configured on a non-wpapsk WLAN at plan time.
+func customizeWLANPrivatePresharedKeys(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	return validatePrivatePresharedKeysRawConfig(d.GetRawConfig())
+}
+
+// validatePrivatePresharedKeysRawConfig holds the pure raw-config logic so it is
+// unit-testable without constructing a ResourceDiff. Unknown (interpolated) values
+// are skipped, they are validated again once known.
+func validatePrivatePresharedKeysRawConfig(raw cty.Value) error {
+	if raw.IsNull() || !raw.Type().HasAttribute("private_preshared_keys") {
+		return nil
+	}
+	keys := raw.GetAttr("private_preshared_keys")
+	if keys.IsNull() || !keys.IsKnown() || keys.LengthInt() == 0 {
+		return nil
+	}
+	if raw.Type().HasAttribute("security") {
+		security := raw.GetAttr("security")
+		if !security.IsNull() && security.IsKnown() && security.AsString() != "wpapsk" {
+			return fmt.Errorf("%q are only valid when %q is %q", "private_preshared_keys", "security", "wpapsk")
+		}
+	}
+	seen := map[string]struct{}{}
+	for it := keys.ElementIterator(); it.Next(); {
+		_, key := it.Element()
+		if key.IsNull() || !key.IsKnown() {
+			continue
+		}
+		passphrase := key.GetAttr("passphrase")
+		if passphrase.IsNull() || !passphrase.IsKnown() {
+			continue
+		}
+		if _, ok := seen[passphrase.AsString()]; ok {
+			// never echo the passphrase itself, it is sensitive
+			return fmt.Errorf("%q must have unique passphrases: the same passphrase is used by more than one key", "private_preshared_keys")
+		}
+		seen[passphrase.AsString()] = struct{}{}
+	}
+	return nil
+}
+
+// customizeWLANPrivatePresharedKeyNetworks verifies that every network referenced by
+// a private pre-shared key exists on the site. Network IDs that are not known yet
+// (e.g. a network created in the same apply) are skipped.
+func customizeWLANPrivatePresharedKeyNetworks(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	c, ok := meta.(*base.Client)
+	if !ok {
+		return fmt.Errorf("unexpected meta type: %T", meta)
+	}
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.Type().HasAttribute("private_preshared_keys") {
+		return nil
+	}
+	keys := raw.GetAttr("private_preshared_keys")
+	if keys.IsNull() || !keys.IsKnown() {
+		return nil
+	}
+	var networkIDs []string
+	for it := keys.ElementIterator(); it.Next(); {
+		_, key := it.Element()
+		if key.IsNull() || !key.IsKnown() {
+			continue
+		}
+		networkID := key.GetAttr("network_id")
+		if networkID.IsNull() || !networkID.IsKnown() {
+			continue
+		}
+		networkIDs = append(networkIDs, networkID.AsString())
+	}
+	if len(networkIDs) == 0 {
+		return nil
+	}
+
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return err
+	}
+	return validatePrivatePresharedKeyNetworks(ctx, c, site, networkIDs)
+}
+
+func validatePrivatePresharedKeyNetworks(ctx context.Context, c unifi.Client, site string, networkIDs []string) error {
+	networks, err := c.ListNetwork(ctx, site)
+	if err != nil {
+		return fmt.Errorf("unable to list networks to validate private_preshared_keys: %w", err)
+	}
+	existing := make(map[string]struct{}, len(networks))
+	for _, n := range networks {
+		existing[n.ID] = struct{}{}
+	}
+	for _, id := range networkIDs {
+		if _, ok := existing[id]; !ok {
+			return fmt.Errorf("network %q referenced in %q does not exist in site %q", id, "private_preshared_keys", site)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+)
+
+func ppskVal(passphrase, networkID cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"passphrase": passphrase,
+		"network_id": networkID,
+	})
+}
+
+var ppskType = cty.Object(map[string]cty.Type{"passphrase": cty.String, "network_id": cty.String})
+
+func TestValidatePrivatePresharedKeysRawConfig(t *testing.T) {
+	rawConfig := func(security cty.Value, keys cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"security":               security,
+			"private_preshared_keys": keys,
+		})
+	}
+	wpapsk := cty.StringVal("wpapsk")
+
+	tests := []struct {
+		name    string
+		raw     cty.Value
+		wantErr bool
+	}{
+		{
+			name: "keys omitted",
+			raw:  rawConfig(wpapsk, cty.NullVal(cty.Set(ppskType))),
+		},
+		{
+			name: "unique keys",
+			raw: rawConfig(wpapsk, cty.SetVal([]cty.Value{
+				ppskVal(cty.StringVal("password1"), cty.StringVal("net1")),
+				ppskVal(cty.StringVal("password2"), cty.StringVal("net1")),
+			})),
+		},
+		{
+			name: "duplicate passphrase on different networks",
+			raw: rawConfig(wpapsk, cty.SetVal([]cty.Value{
+				ppskVal(cty.StringVal("password1"), cty.StringVal("net1")),
+				ppskVal(cty.StringVal("password1"), cty.StringVal("net2")),
+			})),
+			wantErr: true,
+		},
+		{
+			name: "unknown passphrase is skipped",
+			raw: rawConfig(wpapsk, cty.SetVal([]cty.Value{
+				ppskVal(cty.StringVal("password1"), cty.StringVal("net1")),
+				ppskVal(cty.UnknownVal(cty.String), cty.StringVal("net2")),
+			})),
+		},
+		{
+			name: "keys on open network",
+			raw: rawConfig(cty.StringVal("open"), cty.SetVal([]cty.Value{
+				ppskVal(cty.StringVal("password1"), cty.StringVal("net1")),
+			})),
+			wantErr: true,
+		},
+		{
+			name: "null raw config",
+			raw:  cty.NullVal(cty.Object(map[string]cty.Type{"security": cty.String, "private_preshared_keys": cty.Set(ppskType)})),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePrivatePresharedKeysRawConfig(tt.raw)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+type fakeWLANNetworkClient struct {
+	unifi.Client
+
+	networks []unifi.Network
+}
+
+func (f *fakeWLANNetworkClient) ListNetwork(_ context.Context, _ string) ([]unifi.Network, error) {
+	return f.networks, nil
+}
+
+func TestValidatePrivatePresharedKeyNetworks(t *testing.T) {
+	fake := &fakeWLANNetworkClient{networks: []unifi.Network{{ID: "net1"}, {ID: "net2"}}}
+
+	require.NoError(t, validatePrivatePresharedKeyNetworks(context.Background(), fake, "default", []string{"net1", "net2"}))
+
+	err := validatePrivatePresharedKeyNetworks(context.Background(), fake, "default", []string{"net1", "net3"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "net3")
+}
+
+func TestResourceWLANPrivatePresharedKeysRoundTrip(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceWLAN().Schema, map[string]interface{}{
+		"private_preshared_keys": []interface{}{
+			map[string]interface{}{"passphrase": "password1", "network_id": "net1"},
+			map[string]interface{}{"passphrase": "password2", "network_id": "net2"},
+		},
+	})
+
+	set, ok := d.Get("private_preshared_keys").(*schema.Set)
+	require.True(t, ok)
+	keys, err := setToPrivatePresharedKeys(set)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []unifi.WLANPrivatePresharedKeys{
+		{Password: "password1", NetworkID: "net1"},
+		{Password: "password2", NetworkID: "net2"},
+	}, keys)
+
+	assert.ElementsMatch(t, []interface{}{
+		map[string]interface{}{"passphrase": "password1", "network_id": "net1"},
+		map[string]interface{}{"passphrase": "password2", "network_id": "net2"},
+	}, listFromPrivatePresharedKeys(keys))
+}
+
+func TestResourceWLANGetResourceData_dtimAndBroadcastFilter(t *testing.T) {
+	client := &base.Client{Site: "default", Version: base.ControllerV9}
+
+	d := schema.TestResourceDataRaw(t, ResourceWLAN().Schema, map[string]interface{}{
+		"name":                     "tfacc-wlan",
+		"security":                 "open",
+		"user_group_id":            "ug1",
+		"dtim_5g":                  3,
+		"broadcast_filter_enabled": true,
+		"broadcast_filter_list":    []interface{}{"00:11:22:33:44:55"},
+	})
+	req, err := resourceWLANGetResourceData(d, client)
+	require.NoError(t, err)
+	assert.Equal(t, "custom", req.DTIMMode)
+	assert.Equal(t, 3, req.DTIMNa)
+	// Bands not set get the controller's default period, as 0 is invalid.
+	assert.Equal(t, wlanDefaultDTIM2g, req.DTIMNg)
+	assert.Equal(t, wlanDefaultDTIM6g, req.DTIM6E)
+	assert.True(t, req.BroadcastFilterEnabled)
+	assert.Equal(t, []string{"00:11:22:33:44:55"}, req.BroadcastFilterList)
+
+	d = schema.TestResourceDataRaw(t, ResourceWLAN().Schema, map[string]interface{}{
+		"name":          "tfacc-wlan",
+		"security":      "open",
+		"user_group_id": "ug1",
+	})
+	req, err = resourceWLANGetResourceData(d, client)
+	require.NoError(t, err)
+	assert.Equal(t, "default", req.DTIMMode)
+	assert.Nil(t, req.BroadcastFilterList)
+}
+
+func TestResourceWLANSetResourceData_dtim(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceWLAN().Schema, map[string]interface{}{"dtim_2g": 1, "dtim_5g": 3})
+	diags := resourceWLANSetResourceData(&unifi.WLAN{DTIMMode: "custom", DTIMNg: 1, DTIMNa: 3, DTIM6E: 2}, d, "default")
+	require.False(t, diags.HasError())
+	assert.Equal(t, 1, d.Get("dtim_2g"))
+	assert.Equal(t, 3, d.Get("dtim_5g"))
+	assert.Equal(t, 2, d.Get("dtim_6g"))
+
+	// Bands not set that have the default period were filled in on write, so
+	// they stay unset.
+	d = schema.TestResourceDataRaw(t, ResourceWLAN().Schema, map[string]interface{}{"dtim_5g": 5})
+	diags = resourceWLANSetResourceData(&unifi.WLAN{DTIMMode: "custom", DTIMNg: 1, DTIMNa: 5, DTIM6E: 3}, d, "default")
+	require.False(t, diags.HasError())
+	assert.Equal(t, 0, d.Get("dtim_2g"))
+	assert.Equal(t, 5, d.Get("dtim_5g"))
+	assert.Equal(t, 0, d.Get("dtim_6g"))
+
+	// Controller defaults are not surfaced so omitted attributes do not diff.
+	d = schema.TestResourceDataRaw(t, ResourceWLAN().Schema, map[string]interface{}{})
+	diags = resourceWLANSetResourceData(&unifi.WLAN{DTIMMode: "default", DTIMNg: 1, DTIMNa: 3}, d, "default")
+	require.False(t, diags.HasError())
+	assert.Equal(t, 0, d.Get("dtim_5g"))
+}
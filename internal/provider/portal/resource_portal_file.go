@@ -157,7 +157,11 @@ func (r *portalFileResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddError("Invalid file path", fmt.Sprintf("Error accessing file: %s", err))
 		return
 	}
-	site := r.GetClient().ResolveSite(&data)
+	site, diags := r.GetClient().ResolveSite(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	portalFile, err := r.GetClient().UploadPortalFile(ctx, site, filePath)
 	if err != nil {
@@ -170,7 +174,7 @@ func (r *portalFileResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	data.Site = types.StringValue(site)
+	data.Site = ut.NewSiteValue(site)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
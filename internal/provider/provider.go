@@ -29,7 +29,7 @@
 	ProviderAPIKeyDescription   = "API Key for the user accessing the API. Can be specified with the `UNIFI_API_KEY` environment variable. Controller version 9.0.108 or later is required." //nolint:gosec // G101 false positive: human-readable field description, not a credential
 	ProviderAPIURLDescription   = "URL of the controller API. Can be specified with the `UNIFI_API` environment variable. " +
 		"You should **NOT** supply the path (`/api`), the SDK will discover the appropriate paths. This is to support UDM Pro style API paths as well as more standard controller paths."
-	ProviderSiteDescription          = "The site in the Unifi controller this provider will manage. Can be specified with the `UNIFI_SITE` environment variable. Default: `default`"
+	ProviderSiteDescription          = "The site in the Unifi controller this provider will manage, either the internal site name or its description. Can be specified with the `UNIFI_SITE` environment variable. Default: `default`"
 	ProviderAllowInsecureDescription = "Skip verification of TLS certificates of API requests. You may need to set this to `true` " +
 		"if you are using your local API without setting up a signed certificate. Can be specified with the " +
 		"`UNIFI_INSECURE` environment variable."
@@ -114,6 +114,7 @@ func New(version string) func() *schema.Provider {
 			},
 			ResourcesMap: map[string]*schema.Resource{
 				"unifi_device":         device.ResourceDevice(),
+				"unifi_device_port":    device.ResourceDevicePort(),
 				"unifi_dynamic_dns":    dns.ResourceDynamicDNS(),
 				"unifi_firewall_group": firewall.ResourceFirewallGroup(),
 				"unifi_firewall_rule":  firewall.ResourceFirewallRule(),
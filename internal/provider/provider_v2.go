@@ -14,12 +14,21 @@
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/admin"
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/apgroup"
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/controller"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/device"
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/dns"
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/firewall"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/hotspot2"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/network"
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/portal"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/rest"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/routing"
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/settings"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/site"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/user"
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/validators"
 )
@@ -190,17 +199,27 @@ func (p *unifiProvider) Configure(ctx context.Context, req provider.ConfigureReq
 
 func (p *unifiProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		admin.NewAdminResource,
+		admin.NewAdminSiteAccessResource,
 		apgroup.NewAPGroupResource,
+		controller.NewBackupResource,
 		dns.NewDNSRecordResource,
 		firewall.NewFirewallZoneResource,
 		firewall.NewFirewallZonePolicyResource,
 		firewall.NewFirewallZonePolicyOrderResource,
+		hotspot2.NewHotspot2ProfileResource,
+		network.NewNetworkAllocationResource,
 		portal.NewPortalFileResource,
+		rest.NewRestObjectResource,
+		routing.NewNATRuleResource,
+		settings.NewRawSettingResource,
+		settings.NewAutobackupResource,
 		settings.NewAutoSpeedtestResource,
 		settings.NewConnectivityResource,
 		settings.NewCountryResource,
 		settings.NewDpiResource,
 		settings.NewEtherLightingResource,
+		settings.NewGlobalApResource,
 		settings.NewGuestAccessResource,
 		settings.NewIpsResource,
 		settings.NewLcmResource,
@@ -208,21 +227,40 @@ func (p *unifiProvider) Resources(_ context.Context) []func() resource.Resource
 		settings.NewMagicSiteToSiteVpnResource,
 		settings.NewNetworkOptimizationResource,
 		settings.NewNtpResource,
+		settings.NewRadioAIResource,
 		settings.NewRsyslogdResource,
+		settings.NewSnmpResource,
 		settings.NewSslInspectionResource,
 		settings.NewTeleportResource,
 		settings.NewMgmtResource,
 		settings.NewUsgResource,
 		settings.NewUswResource,
 		settings.NewGlobalSwitchResource,
+		settings.NewSuperCloudAccessResource,
+		settings.NewSuperFwupdateResource,
+		settings.NewSuperIdentityResource,
+		settings.NewSuperSmtpResource,
 	}
 }
 
 func (p *unifiProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		apgroup.NewAPGroupDatasource,
+		controller.NewControllerDatasource,
+		device.NewDeviceDatasource,
+		device.NewDeviceModelDatasource,
+		device.NewDevicesDatasource,
 		dns.NewDNSRecordsDatasource,
 		dns.NewDNSRecordDatasource,
+		firewall.NewFirewallGroupsDatasource,
 		firewall.NewFirewallZoneDatasource,
+		firewall.NewFirewallZonePoliciesDatasource,
+		network.NewNetworksDatasource,
+		network.NewWLANsDatasource,
+		rest.NewRestObjectsDatasource,
+		routing.NewPortForwardsDatasource,
+		routing.NewStaticRoutesDatasource,
+		site.NewSitesDatasource,
+		user.NewClientsDatasource,
 	}
 }
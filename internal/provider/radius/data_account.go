@@ -72,9 +72,9 @@ func dataAccountRead(ctx context.Context, d *schema.ResourceData, meta interface
 	}
 
 	name, _ := d.Get("name").(string)
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	accounts, err := c.ListAccount(ctx, site)
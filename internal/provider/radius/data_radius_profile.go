@@ -44,9 +44,9 @@ func dataRADIUSProfileRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	name, _ := d.Get("name").(string)
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	profiles, err := c.ListRADIUSProfile(ctx, site)
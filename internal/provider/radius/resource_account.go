@@ -49,11 +49,12 @@ func ResourceAccount() *schema.Resource {
 				Computed:    true,
 			},
 			"site": {
-				Description: "The name of the UniFi site where this RADIUS account should be created. If not specified, the default site will be used.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				ForceNew:    true,
+				Description:      "The name of the UniFi site where this RADIUS account should be created. If not specified, the default site will be used.",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: utils.SiteDiffSuppressFunc,
 			},
 			"name": {
 				Description: "The username for this RADIUS account. For regular users, this can be any unique identifier. For MAC-based " +
@@ -118,9 +119,9 @@ func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	req := resourceAccountGetResourceData(d)
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.CreateAccount(ctx, site, req)
@@ -139,9 +140,9 @@ func resourceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf("unexpected meta type: %T", meta)
 	}
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	req := resourceAccountGetResourceData(d)
@@ -174,13 +175,13 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	// name := d.Get("name").(string)
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	id := d.Id()
-	err := c.DeleteAccount(ctx, site, id)
+	err = c.DeleteAccount(ctx, site, id)
 	if errors.Is(err, unifi.ErrNotFound) {
 		return nil
 	}
@@ -195,9 +196,9 @@ func resourceAccountRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.GetAccount(ctx, site, id)
@@ -43,11 +43,12 @@ func ResourceRadiusProfile() *schema.Resource {
 				Computed:    true,
 			},
 			"site": {
-				Description: "The name of the UniFi site where the RADIUS profile should be created. If not specified, the default site will be used.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				ForceNew:    true,
+				Description:      "The name of the UniFi site where the RADIUS profile should be created. If not specified, the default site will be used.",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: utils.SiteDiffSuppressFunc,
 			},
 			"name": {
 				Description: "A friendly name for the RADIUS profile to help identify its purpose (e.g., 'Corporate Users' or 'Guest Access').",
@@ -261,9 +262,9 @@ func resourceRadiusProfileCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	resp, err := c.CreateRADIUSProfile(ctx, site, req)
 	if err != nil {
@@ -356,9 +357,9 @@ func resourceRadiusProfileRead(ctx context.Context, d *schema.ResourceData, meta
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	resp, err := c.GetRADIUSProfile(ctx, site, id)
 	if errors.Is(err, unifi.ErrNotFound) {
@@ -385,9 +386,9 @@ func resourceRadiusProfileUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	req.ID = d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	req.SiteID = site
 
@@ -417,12 +418,12 @@ func resourceRadiusProfileDelete(ctx context.Context, d *schema.ResourceData, me
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	err := c.DeleteRADIUSProfile(ctx, site, id)
+	err = c.DeleteRADIUSProfile(ctx, site, id)
 	return diag.FromErr(err)
 }
 
@@ -432,14 +433,16 @@ func importRadiusProfile(ctx context.Context, d *schema.ResourceData, meta inter
 		return nil, fmt.Errorf("unexpected meta type: %T", meta)
 	}
 	id := d.Id()
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return nil, err
 	}
 
 	if strings.Contains(id, ":") {
 		importParts := strings.SplitN(id, ":", 2)
-		site = importParts[0]
+		if site, err = c.ResolveSiteName(importParts[0]); err != nil {
+			return nil, err
+		}
 		id = importParts[1]
 	}
 
@@ -0,0 +1,122 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/filipowm/go-unifi/unifi"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+)
+
+const (
+	legacyPrefix = "rest/"
+	v2Prefix     = "v2/"
+)
+
+// collectionPathRegex matches the path of a collection relative to the site.
+var collectionPathRegex = regexp.MustCompile(`^(rest|v2)/[A-Za-z0-9_-]+(/[A-Za-z0-9_-]+)*$`)
+
+// restObject is an object of a collection, as returned by the controller.
+type restObject map[string]interface{}
+
+// id returns the ID of the object, stored in the given field.
+func (o restObject) id(idField string) string {
+	id, _ := o[idField].(string)
+	return id
+}
+
+// collection is a site-scoped collection of controller objects, addressed by
+// its path relative to the site: `rest/<name>` for legacy collections, e.g.
+// `rest/portforward`, and `v2/<name>` for collections of the v2 API, e.g.
+// `v2/trafficrules`. Legacy responses are wrapped in an envelope, v2 responses
+// are not, and v2 collections cannot always be read by object ID.
+type collection struct {
+	site    string
+	name    string
+	v2      bool
+	idField string
+}
+
+func newCollection(site, path, idField string) (*collection, error) {
+	if !collectionPathRegex.MatchString(path) {
+		return nil, fmt.Errorf("invalid collection path %q, expected `rest/<name>` or `v2/<name>`", path)
+	}
+	if name, ok := strings.CutPrefix(path, v2Prefix); ok {
+		return &collection{site: site, name: name, v2: true, idField: idField}, nil
+	}
+	return &collection{site: site, name: strings.TrimPrefix(path, legacyPrefix), idField: idField}, nil
+}
+
+func (c *collection) list(ctx context.Context, client unifi.Client) ([]restObject, error) {
+	if !c.v2 {
+		return base.ListRest[restObject](ctx, client, c.site, c.name)
+	}
+	var objects []restObject
+	if err := client.Do(ctx, http.MethodGet, base.V2Path(c.site, c.name), nil, &objects); err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// get reads an object by its ID. Objects of v2 collections are looked up in
+// the list of the collection, as not all of them can be read by ID.
+func (c *collection) get(ctx context.Context, client unifi.Client, id string) (restObject, error) {
+	if !c.v2 {
+		o, err := base.GetRest[restObject](ctx, client, c.site, c.name, id)
+		if err != nil {
+			return nil, err
+		}
+		return *o, nil
+	}
+	objects, err := c.list(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range objects {
+		if o.id(c.idField) == id {
+			return o, nil
+		}
+	}
+	return nil, unifi.ErrNotFound
+}
+
+func (c *collection) create(ctx context.Context, client unifi.Client, body restObject) (restObject, error) {
+	if !c.v2 {
+		o, err := base.CreateRest(ctx, client, c.site, c.name, &body)
+		if err != nil {
+			return nil, err
+		}
+		return *o, nil
+	}
+	var o restObject
+	if err := client.Do(ctx, http.MethodPost, base.V2Path(c.site, c.name), body, &o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func (c *collection) update(ctx context.Context, client unifi.Client, id string, body restObject) (restObject, error) {
+	if !c.v2 {
+		o, err := base.UpdateRest(ctx, client, c.site, c.name, id, &body)
+		if err != nil {
+			return nil, err
+		}
+		return *o, nil
+	}
+	var o restObject
+	if err := client.Do(ctx, http.MethodPut, base.V2Path(c.site, c.name, id), body, &o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func (c *collection) delete(ctx context.Context, client unifi.Client, id string) error {
+	if !c.v2 {
+		return base.DeleteRest(ctx, client, c.site, c.name, id)
+	}
+	return client.Do(ctx, http.MethodDelete, base.V2Path(c.site, c.name, id), struct{}{}, nil)
+}
@@ -0,0 +1,92 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClient answers every Do call with a canned JSON body and records the
+// request it received.
+type fakeClient struct {
+	unifi.Client
+
+	response string
+	method   string
+	path     string
+}
+
+func (f *fakeClient) Do(_ context.Context, method, apiPath string, _ interface{}, respBody interface{}) error {
+	f.method = method
+	f.path = apiPath
+	if respBody == nil {
+		return nil
+	}
+	return json.Unmarshal([]byte(f.response), respBody)
+}
+
+func TestNewCollection(t *testing.T) {
+	c, err := newCollection("default", "rest/portforward", "_id")
+	require.NoError(t, err)
+	assert.False(t, c.v2)
+	assert.Equal(t, "portforward", c.name)
+
+	c, err = newCollection("default", "v2/trafficrules", "_id")
+	require.NoError(t, err)
+	assert.True(t, c.v2)
+	assert.Equal(t, "trafficrules", c.name)
+
+	for _, p := range []string{"portforward", "/rest/portforward", "stat/device", "rest/../cmd", "v2/"} {
+		_, err = newCollection("default", p, "_id")
+		assert.Error(t, err, p)
+	}
+}
+
+func TestCollection_legacy(t *testing.T) {
+	ctx := context.Background()
+	coll, err := newCollection("site1", "rest/portforward", "_id")
+	require.NoError(t, err)
+
+	client := &fakeClient{response: `{"meta":{"rc":"ok"},"data":[{"_id":"abc","name":"test"}]}`}
+	o, err := coll.get(ctx, client, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", o.id("_id"))
+	assert.Equal(t, http.MethodGet, client.method)
+	assert.Equal(t, "s/site1/rest/portforward/abc", client.path)
+
+	_, err = coll.update(ctx, client, "abc", restObject{"name": "test"})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, client.method)
+	assert.Equal(t, "s/site1/rest/portforward/abc", client.path)
+}
+
+func TestCollection_v2(t *testing.T) {
+	ctx := context.Background()
+	coll, err := newCollection("site1", "v2/trafficrules", "_id")
+	require.NoError(t, err)
+
+	client := &fakeClient{response: `[{"_id":"abc","description":"a"},{"_id":"def","description":"b"}]`}
+	o, err := coll.get(ctx, client, "def")
+	require.NoError(t, err)
+	assert.Equal(t, "b", o["description"])
+	assert.Equal(t, http.MethodGet, client.method)
+	assert.Equal(t, "../v2/api/site/site1/trafficrules", client.path)
+
+	_, err = coll.get(ctx, client, "xyz")
+	assert.ErrorIs(t, err, unifi.ErrNotFound)
+
+	client.response = `{"_id":"abc","description":"c"}`
+	o, err = coll.create(ctx, client, restObject{"description": "c"})
+	require.NoError(t, err)
+	assert.Equal(t, "abc", o.id("_id"))
+	assert.Equal(t, http.MethodPost, client.method)
+
+	require.NoError(t, coll.delete(ctx, client, "abc"))
+	assert.Equal(t, http.MethodDelete, client.method)
+	assert.Equal(t, "../v2/api/site/site1/trafficrules/abc", client.path)
+}
@@ -0,0 +1,131 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+type restObjectsDatasourceModel struct {
+	Site    ut.SiteValue `tfsdk:"site"`
+	Path    types.String `tfsdk:"path"`
+	Objects types.List   `tfsdk:"objects"`
+}
+
+func (m *restObjectsDatasourceModel) GetSite() string {
+	return m.Site.ValueString()
+}
+
+func (m *restObjectsDatasourceModel) GetRawSite() types.String {
+	return m.Site.StringValue
+}
+
+func (m *restObjectsDatasourceModel) SetSite(site string) {
+	m.Site = ut.NewSiteValue(site)
+}
+
+var (
+	_ datasource.DataSource              = &restObjectsDatasource{}
+	_ datasource.DataSourceWithConfigure = &restObjectsDatasource{}
+	_ base.Resource                      = &restObjectsDatasource{}
+)
+
+type restObjectsDatasource struct {
+	base.ControllerVersionValidator
+	base.FeatureValidator
+	client *base.Client
+}
+
+func NewRestObjectsDatasource() datasource.DataSource {
+	return &restObjectsDatasource{}
+}
+
+func (d *restObjectsDatasource) SetClient(client *base.Client) {
+	d.client = client
+}
+
+func (d *restObjectsDatasource) SetVersionValidator(validator base.ControllerVersionValidator) {
+	d.ControllerVersionValidator = validator
+}
+
+func (d *restObjectsDatasource) SetFeatureValidator(validator base.FeatureValidator) {
+	d.FeatureValidator = validator
+}
+
+func (d *restObjectsDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	base.ConfigureDatasource(d, req, resp)
+}
+
+func (d *restObjectsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rest_objects"
+}
+
+func (d *restObjectsDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_rest_objects` data source lists the objects of any collection of the UniFi controller API, " +
+			"for objects not modeled by a dedicated data source yet. Decode the objects with `jsondecode()`.",
+		Attributes: map[string]schema.Attribute{
+			"site": ut.SiteAttribute(),
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path of the collection relative to the site: `rest/<name>` for collections of the legacy API, e.g. `rest/portforward`, " +
+					"or `v2/<name>` for collections of the v2 API, e.g. `v2/trafficrules`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(collectionPathRegex, "must be `rest/<name>` or `v2/<name>`"),
+				},
+			},
+			"objects": schema.ListAttribute{
+				MarkdownDescription: "The objects of the collection, each as a JSON-encoded object.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *restObjectsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state restObjectsDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	site, diags := d.client.ResolveSite(&state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	coll, err := newCollection(site, state.Path.ValueString(), defaultIDAttribute)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Invalid collection path", err.Error())
+		return
+	}
+	objects, err := coll.list(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing objects", err.Error())
+		return
+	}
+	encoded := make([]string, 0, len(objects))
+	for _, o := range objects {
+		b, err := json.Marshal(o)
+		if err != nil {
+			resp.Diagnostics.AddError("Cannot encode object", err.Error())
+			return
+		}
+		encoded = append(encoded, string(b))
+	}
+
+	state.Objects, diags = types.ListValueFrom(ctx, types.StringType, encoded)
+	resp.Diagnostics.Append(diags...)
+	state.SetSite(site)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
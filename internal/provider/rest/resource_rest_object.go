@@ -0,0 +1,335 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/validators"
+)
+
+const defaultIDAttribute = "_id"
+
+// restObjectModel represents an object of any controller collection. Only the
+// fields of the body are managed.
+type restObjectModel struct {
+	base.Model
+	Path         types.String `tfsdk:"path"`
+	IDAttribute  types.String `tfsdk:"id_attribute"`
+	Body         ut.JSONValue `tfsdk:"body"`
+	IgnoreFields types.Set    `tfsdk:"ignore_fields"`
+}
+
+func (m *restObjectModel) idField() string {
+	if ut.IsEmptyString(m.IDAttribute) {
+		return defaultIDAttribute
+	}
+	return m.IDAttribute.ValueString()
+}
+
+func (m *restObjectModel) collection() (*collection, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	c, err := newCollection(m.GetSite(), m.Path.ValueString(), m.idField())
+	if err != nil {
+		diags.AddAttributeError(path.Root("path"), "Invalid collection path", err.Error())
+	}
+	return c, diags
+}
+
+func (m *restObjectModel) AsUnifiModel(_ context.Context) (interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	body := restObject{}
+	if err := json.Unmarshal([]byte(m.Body.ValueString()), &body); err != nil {
+		diags.AddAttributeError(path.Root("body"), "Invalid object body", err.Error())
+		return nil, diags
+	}
+	return body, diags
+}
+
+// Merge sets the body to the fields of the object managed so far, i.e. the
+// fields of the current body. Ignored fields keep their value in the body.
+// Without a current body, as when importing, all fields of the object but the
+// ignored ones are managed.
+func (m *restObjectModel) Merge(ctx context.Context, other interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	object, ok := other.(restObject)
+	if !ok {
+		diags.AddError("Cannot merge", "Cannot merge type that is not restObject")
+		return diags
+	}
+
+	var ignored []string
+	if ut.IsDefined(m.IgnoreFields) {
+		diags.Append(m.IgnoreFields.ElementsAs(ctx, &ignored, false)...)
+	}
+	var managed restObject
+	if ut.IsDefined(m.Body) {
+		if err := json.Unmarshal([]byte(m.Body.ValueString()), &managed); err != nil {
+			diags.AddAttributeError(path.Root("body"), "Invalid object body", err.Error())
+			return diags
+		}
+	}
+
+	m.ID = types.StringValue(object.id(m.idField()))
+	body := restObject{}
+	for field, v := range object {
+		if field == m.idField() || field == "site_id" {
+			continue
+		}
+		current, isManaged := managed[field]
+		switch {
+		case slices.Contains(ignored, field):
+			if isManaged {
+				body[field] = current
+			}
+		case managed == nil || isManaged:
+			body[field] = v
+		}
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		diags.AddError("Cannot encode object body", err.Error())
+		return diags
+	}
+	m.Body = ut.NewJSONValue(string(b))
+	return diags
+}
+
+var (
+	_ base.ResourceModel               = &restObjectModel{}
+	_ resource.Resource                = &restObjectResource{}
+	_ resource.ResourceWithConfigure   = &restObjectResource{}
+	_ resource.ResourceWithImportState = &restObjectResource{}
+)
+
+type restObjectResource struct {
+	*base.GenericResource[*restObjectModel]
+}
+
+// NewRestObjectResource creates a new instance of the REST object resource. It
+// embeds GenericResource to inherit the shared Configure/Metadata/client
+// infrastructure; all CRUD is overridden below, as the collection of the
+// object is part of the model.
+func NewRestObjectResource() resource.Resource {
+	return &restObjectResource{
+		GenericResource: base.NewGenericResource(
+			"unifi_rest_object",
+			func() *restObjectModel { return &restObjectModel{} },
+			base.ResourceFunctions{},
+		),
+	}
+}
+
+func (r *restObjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_rest_object` resource manages an object of any collection of the UniFi controller API, for objects not modeled by a dedicated resource yet, " +
+			"like traffic rules or schedules.\n\n" +
+			"Only the fields given in `body` are managed: other fields of the object are left as set on the controller. " +
+			"The controller must return the fields as sent, otherwise list them in `ignore_fields`. " +
+			"Prefer the dedicated resource of an object where one exists, as it validates the object.",
+		Attributes: map[string]schema.Attribute{
+			"id":   ut.ID("The ID of the object."),
+			"site": ut.SiteAttribute(),
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path of the collection relative to the site: `rest/<name>` for collections of the legacy API, e.g. `rest/portforward`, " +
+					"or `v2/<name>` for collections of the v2 API, e.g. `v2/trafficrules`. Changing it replaces the resource.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(collectionPathRegex, "must be `rest/<name>` or `v2/<name>`"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id_attribute": schema.StringAttribute{
+				MarkdownDescription: "The field of the object holding its ID. Defaults to `_id`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultIDAttribute),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "The fields of the object to manage as a JSON-encoded object, e.g. using `jsonencode()`. " +
+					"The object read from the controller is compared as a JSON document, so its formatting and key order do not produce a diff.",
+				CustomType: ut.JSONType{},
+				Required:   true,
+				Validators: []validator.String{
+					validators.JSONObject(defaultIDAttribute, "site_id"),
+				},
+			},
+			"ignore_fields": schema.SetAttribute{
+				MarkdownDescription: "The fields of `body` whose value read from the controller is ignored, e.g. passwords the controller does not return.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *restObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model restObjectModel
+	coll, body := r.prepare(ctx, req.Plan.Get, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	object, err := coll.create(ctx, r.GetClient(), body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating object", err.Error())
+		return
+	}
+	if object.id(coll.idField) == "" {
+		resp.Diagnostics.AddError("Error creating object", "The controller did not return the `"+coll.idField+"` of the object. Set `id_attribute` to the field holding its ID.")
+		return
+	}
+	resp.Diagnostics.Append(model.Merge(ctx, object)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Update overlays the body on the current object, as objects of v2
+// collections must be sent whole.
+func (r *restObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model restObjectModel
+	coll, body := r.prepare(ctx, req.Plan.Get, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := coll.get(ctx, r.GetClient(), model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading object", err.Error())
+		return
+	}
+	for field, v := range body {
+		current[field] = v
+	}
+	object, err := coll.update(ctx, r.GetClient(), model.ID.ValueString(), current)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating object", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(model.Merge(ctx, object)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *restObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model restObjectModel
+	coll, _ := r.prepare(ctx, req.State.Get, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.read(ctx, coll, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *restObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model restObjectModel
+	coll, _ := r.prepare(ctx, req.State.Get, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := coll.delete(ctx, r.GetClient(), model.ID.ValueString()); err != nil && !errors.Is(err, unifi.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting object", err.Error())
+	}
+}
+
+// ImportState imports an object via `<site>:<path>:<id>`.
+func (r *restObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "Expected configured client. Please report this issue to the provider developers.")
+		return
+	}
+
+	// ImportIDWithSite splits on the FIRST colon, so `site:path:id` yields
+	// site=`site`, id=`path:id`.
+	id, site := base.ImportIDWithSite(r.GetClient(), req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	collectionPath, objectID, ok := strings.Cut(id, ":")
+	if !ok || collectionPath == "" || objectID == "" {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected import ID in the format `<site>:<path>:<id>`")
+		return
+	}
+
+	model := restObjectModel{
+		Path:         types.StringValue(collectionPath),
+		IDAttribute:  types.StringValue(defaultIDAttribute),
+		Body:         ut.JSONValue{StringValue: types.StringNull()},
+		IgnoreFields: types.SetNull(types.StringType),
+	}
+	model.SetID(objectID)
+	model.SetSite(site)
+	coll, diags := model.collection()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.read(ctx, coll, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// prepare loads the model, resolving its site, and returns its collection and
+// body.
+func (r *restObjectResource) prepare(ctx context.Context, get func(context.Context, interface{}) diag.Diagnostics, model *restObjectModel, diags *diag.Diagnostics) (*collection, restObject) {
+	if r.GetClient() == nil {
+		diags.AddError("Client Not Configured", "Expected configured client. Please report this issue to the provider developers.")
+		return nil, nil
+	}
+	diags.Append(get(ctx, model)...)
+	if diags.HasError() {
+		return nil, nil
+	}
+	site, d := r.GetClient().ResolveSite(model)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, nil
+	}
+	model.SetSite(site)
+	coll, d := model.collection()
+	diags.Append(d...)
+	if diags.HasError() || !ut.IsDefined(model.Body) {
+		return coll, nil
+	}
+	body, d := model.AsUnifiModel(ctx)
+	diags.Append(d...)
+	object, _ := body.(restObject)
+	return coll, object
+}
+
+func (r *restObjectResource) read(ctx context.Context, coll *collection, model *restObjectModel, diags *diag.Diagnostics) {
+	object, err := coll.get(ctx, r.GetClient(), model.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, unifi.ErrNotFound) {
+			diags.AddError("Resource not found", "The resource was not found in the UniFi controller")
+		} else {
+			diags.AddError("Error reading resource", err.Error())
+		}
+		return
+	}
+	diags.Append(model.Merge(ctx, object)...)
+}
@@ -0,0 +1,51 @@
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+func TestRestObjectModel_Merge(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	object := restObject{
+		"_id":        "abc",
+		"site_id":    "site",
+		"name":       "web",
+		"enabled":    true,
+		"x_password": "",
+		"dst_port":   "443",
+	}
+
+	// Only the fields of the body are managed, ignored fields keep their value.
+	model := restObjectModel{
+		IDAttribute: types.StringValue("_id"),
+		Body:        ut.NewJSONValue(`{"name": "old", "x_password": "secret"}`),
+	}
+	model.IgnoreFields, _ = types.SetValueFrom(ctx, types.StringType, []string{"x_password"})
+	require.False(t, model.Merge(ctx, object).HasError())
+	assert.Equal(t, "abc", model.ID.ValueString())
+	assert.JSONEq(t, `{"name": "web", "x_password": "secret"}`, model.Body.ValueString())
+
+	// Without a body, all fields but the ID, the site and ignored fields are
+	// managed.
+	model.Body = ut.JSONValue{StringValue: types.StringNull()}
+	require.False(t, model.Merge(ctx, object).HasError())
+	assert.JSONEq(t, `{"name": "web", "enabled": true, "dst_port": "443"}`, model.Body.ValueString())
+}
+
+func TestRestObjectModel_AsUnifiModel(t *testing.T) {
+	t.Parallel()
+
+	model := restObjectModel{Body: ut.NewJSONValue(`{"name": "web", "enabled": true}`)}
+	body, diags := model.AsUnifiModel(context.Background())
+	require.False(t, diags.HasError())
+	assert.Equal(t, restObject{"name": "web", "enabled": true}, body)
+}
@@ -0,0 +1,112 @@
+package routing
+
+import (
+	"context"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+type portForwardListItemModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Protocol             types.String `tfsdk:"protocol"`
+	DstPort              types.String `tfsdk:"dst_port"`
+	FwdIP                types.String `tfsdk:"fwd_ip"`
+	FwdPort              types.String `tfsdk:"fwd_port"`
+	SrcIP                types.String `tfsdk:"src_ip"`
+	PortForwardInterface types.String `tfsdk:"port_forward_interface"`
+	Log                  types.Bool   `tfsdk:"log"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+}
+
+var (
+	_ datasource.DataSource              = &portForwardsDatasource{}
+	_ datasource.DataSourceWithConfigure = &portForwardsDatasource{}
+	_ base.Resource                      = &portForwardsDatasource{}
+)
+
+type portForwardsDatasource struct {
+	*base.GenericListDatasource[unifi.PortForward, portForwardListItemModel]
+}
+
+func NewPortForwardsDatasource() datasource.DataSource {
+	return &portForwardsDatasource{
+		GenericListDatasource: base.NewGenericListDatasource(
+			"unifi_port_forwards",
+			"The `unifi_port_forwards` data source lists the port forwarding rules of a site, optionally filtered by name, "+
+				"protocol or enabled state.",
+			map[string]schema.Attribute{
+				"id": ut.ID("The ID of the port forwarding rule."),
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The name of the port forwarding rule.",
+					Computed:            true,
+				},
+				"protocol": schema.StringAttribute{
+					MarkdownDescription: "The protocol of the rule, `tcp_udp`, `tcp` or `udp`.",
+					Computed:            true,
+				},
+				"dst_port": schema.StringAttribute{
+					MarkdownDescription: "The external port(s) forwarded.",
+					Computed:            true,
+				},
+				"fwd_ip": schema.StringAttribute{
+					MarkdownDescription: "The IP address traffic is forwarded to.",
+					Computed:            true,
+				},
+				"fwd_port": schema.StringAttribute{
+					MarkdownDescription: "The port(s) traffic is forwarded to.",
+					Computed:            true,
+				},
+				"src_ip": schema.StringAttribute{
+					MarkdownDescription: "The source IP address or network allowed to use the rule, `any` for all.",
+					Computed:            true,
+				},
+				"port_forward_interface": schema.StringAttribute{
+					MarkdownDescription: "The WAN interface of the rule, `wan`, `wan2` or `both`.",
+					Computed:            true,
+				},
+				"log": schema.BoolAttribute{
+					MarkdownDescription: "Whether forwarded traffic is logged.",
+					Computed:            true,
+				},
+				"enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether the rule is enabled.",
+					Computed:            true,
+				},
+			},
+			base.ListFilters{
+				Purpose: "Only return rules of this protocol, `tcp_udp`, `tcp` or `udp`.",
+				Enabled: true,
+			},
+			base.ListDatasourceFunctions[unifi.PortForward, portForwardListItemModel]{
+				List: func(ctx context.Context, client *base.Client, site string) ([]unifi.PortForward, error) {
+					return client.ListPortForward(ctx, site)
+				},
+				Describe: func(pf unifi.PortForward) base.ListItem {
+					return base.ListItem{Name: pf.Name, Purpose: pf.Proto, Enabled: pf.Enabled}
+				},
+				Convert: func(_ context.Context, pf unifi.PortForward) (portForwardListItemModel, diag.Diagnostics) {
+					return portForwardListItemModel{
+						ID:                   types.StringValue(pf.ID),
+						Name:                 types.StringValue(pf.Name),
+						Protocol:             types.StringValue(pf.Proto),
+						DstPort:              types.StringValue(pf.DstPort),
+						FwdIP:                types.StringValue(pf.Fwd),
+						FwdPort:              types.StringValue(pf.FwdPort),
+						SrcIP:                types.StringValue(pf.Src),
+						PortForwardInterface: types.StringValue(pf.PfwdInterface),
+						Log:                  types.BoolValue(pf.Log),
+						Enabled:              types.BoolValue(pf.Enabled),
+					}, nil
+				},
+			},
+		),
+	}
+}
@@ -0,0 +1,101 @@
+package routing
+
+import (
+	"context"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
+)
+
+type staticRouteListItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Network   types.String `tfsdk:"network"`
+	Distance  types.Int64  `tfsdk:"distance"`
+	NextHop   types.String `tfsdk:"next_hop"`
+	Interface types.String `tfsdk:"interface"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+}
+
+var (
+	_ datasource.DataSource              = &staticRoutesDatasource{}
+	_ datasource.DataSourceWithConfigure = &staticRoutesDatasource{}
+	_ base.Resource                      = &staticRoutesDatasource{}
+)
+
+type staticRoutesDatasource struct {
+	*base.GenericListDatasource[unifi.Routing, staticRouteListItemModel]
+}
+
+func NewStaticRoutesDatasource() datasource.DataSource {
+	return &staticRoutesDatasource{
+		GenericListDatasource: base.NewGenericListDatasource(
+			"unifi_static_routes",
+			"The `unifi_static_routes` data source lists the static routes of a site, optionally filtered by name, route "+
+				"type or enabled state.",
+			map[string]schema.Attribute{
+				"id": ut.ID("The ID of the static route."),
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The name of the static route.",
+					Computed:            true,
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The type of the route, `interface-route`, `nexthop-route` or `blackhole`.",
+					Computed:            true,
+				},
+				"network": schema.StringAttribute{
+					MarkdownDescription: "The destination network of the route in CIDR notation.",
+					Computed:            true,
+				},
+				"distance": schema.Int64Attribute{
+					MarkdownDescription: "The administrative distance of the route.",
+					Computed:            true,
+				},
+				"next_hop": schema.StringAttribute{
+					MarkdownDescription: "The next hop of a `nexthop-route`.",
+					Computed:            true,
+				},
+				"interface": schema.StringAttribute{
+					MarkdownDescription: "The interface of an `interface-route`.",
+					Computed:            true,
+				},
+				"enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether the route is enabled.",
+					Computed:            true,
+				},
+			},
+			base.ListFilters{
+				Purpose: "Only return routes of this type, `interface-route`, `nexthop-route` or `blackhole`.",
+				Enabled: true,
+			},
+			base.ListDatasourceFunctions[unifi.Routing, staticRouteListItemModel]{
+				List: func(ctx context.Context, client *base.Client, site string) ([]unifi.Routing, error) {
+					return client.ListRouting(ctx, site)
+				},
+				Describe: func(r unifi.Routing) base.ListItem {
+					return base.ListItem{Name: r.Name, Purpose: r.StaticRouteType, Enabled: r.Enabled}
+				},
+				Convert: func(_ context.Context, r unifi.Routing) (staticRouteListItemModel, diag.Diagnostics) {
+					return staticRouteListItemModel{
+						ID:        types.StringValue(r.ID),
+						Name:      types.StringValue(r.Name),
+						Type:      types.StringValue(r.StaticRouteType),
+						Network:   types.StringValue(utils.CidrZeroBased(r.StaticRouteNetwork)),
+						Distance:  types.Int64Value(int64(r.StaticRouteDistance)),
+						NextHop:   ut.StringOrNull(r.StaticRouteNexthop),
+						Interface: ut.StringOrNull(r.StaticRouteInterface),
+						Enabled:   types.BoolValue(r.Enabled),
+					}, nil
+				},
+			},
+		),
+	}
+}
@@ -0,0 +1,501 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/validators"
+)
+
+var (
+	_ resource.Resource                     = &natRuleResource{}
+	_ resource.ResourceWithConfigure        = &natRuleResource{}
+	_ resource.ResourceWithConfigValidators = &natRuleResource{}
+	_ resource.ResourceWithValidateConfig   = &natRuleResource{}
+	_ resource.ResourceWithImportState      = &natRuleResource{}
+	_ resource.ResourceWithModifyPlan       = &natRuleResource{}
+	_ base.Resource                         = &natRuleResource{}
+)
+
+const natCollection = "nat"
+
+// natRuleFilter matches the source or destination of the traffic of a NAT rule.
+type natRuleFilter struct {
+	FilterType       string   `json:"filter_type"`
+	Address          string   `json:"address,omitempty"`
+	Port             string   `json:"port,omitempty"`
+	InvertAddress    bool     `json:"invert_address"`
+	InvertPort       bool     `json:"invert_port"`
+	FirewallGroupIDs []string `json:"firewall_group_ids"`
+}
+
+// natRule is a NAT rule of the v2 API. go-unifi does not model NAT rules, so
+// they are read and written with raw requests.
+type natRule struct {
+	ID                string         `json:"_id,omitempty"`
+	Description       string         `json:"description"`
+	Enabled           bool           `json:"enabled"`
+	Type              string         `json:"type"`
+	IPVersion         string         `json:"ip_version"`
+	Protocol          string         `json:"protocol"`
+	InInterface       string         `json:"in_interface,omitempty"`
+	OutInterface      string         `json:"out_interface,omitempty"`
+	IPAddress         string         `json:"ip_address,omitempty"`
+	Port              string         `json:"port,omitempty"`
+	RuleIndex         int            `json:"rule_index,omitempty"`
+	Logging           bool           `json:"logging"`
+	Exclude           bool           `json:"exclude"`
+	SourceFilter      *natRuleFilter `json:"source_filter,omitempty"`
+	DestinationFilter *natRuleFilter `json:"destination_filter,omitempty"`
+}
+
+func listNATRules(ctx context.Context, client *base.Client, site string) ([]natRule, error) {
+	var rules []natRule
+	if err := client.Do(ctx, http.MethodGet, base.V2Path(site, natCollection), nil, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// getNATRule looks the rule up in the list of rules of the site, as the v2 API
+// cannot read a single rule by ID.
+func getNATRule(ctx context.Context, client *base.Client, site, id string) (*natRule, error) {
+	rules, err := listNATRules(ctx, client, site)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rules {
+		if r.ID == id {
+			return &r, nil
+		}
+	}
+	return nil, unifi.ErrNotFound
+}
+
+func createNATRule(ctx context.Context, client *base.Client, site string, rule *natRule) (*natRule, error) {
+	var resp natRule
+	if err := client.Do(ctx, http.MethodPost, base.V2Path(site, natCollection), rule, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func updateNATRule(ctx context.Context, client *base.Client, site string, rule *natRule) (*natRule, error) {
+	var resp natRule
+	if err := client.Do(ctx, http.MethodPut, base.V2Path(site, natCollection, rule.ID), rule, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func deleteNATRule(ctx context.Context, client *base.Client, site, id string) error {
+	return client.Do(ctx, http.MethodDelete, base.V2Path(site, natCollection, id), struct{}{}, nil)
+}
+
+// NATRuleFilterModel matches the source or destination of the traffic.
+type NATRuleFilterModel struct {
+	Address          types.String `tfsdk:"address"`
+	Port             types.String `tfsdk:"port"`
+	InvertAddress    types.Bool   `tfsdk:"invert_address"`
+	InvertPort       types.Bool   `tfsdk:"invert_port"`
+	FirewallGroupIDs types.List   `tfsdk:"firewall_group_ids"`
+}
+
+func (m *NATRuleFilterModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"address":            types.StringType,
+		"port":               types.StringType,
+		"invert_address":     types.BoolType,
+		"invert_port":        types.BoolType,
+		"firewall_group_ids": types.ListType{ElemType: types.StringType},
+	}
+}
+
+// asUnifiModel builds the filter of the controller. Its type is derived from
+// the attributes set, as the controller ignores attributes not matching it.
+func (m *NATRuleFilterModel) asUnifiModel(ctx context.Context) (*natRuleFilter, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	filter := &natRuleFilter{
+		FilterType:       "NONE",
+		Address:          m.Address.ValueString(),
+		Port:             m.Port.ValueString(),
+		InvertAddress:    m.InvertAddress.ValueBool(),
+		InvertPort:       m.InvertPort.ValueBool(),
+		FirewallGroupIDs: []string{},
+	}
+	if ut.IsDefined(m.FirewallGroupIDs) {
+		diags.Append(ut.ListElementsAs(ctx, m.FirewallGroupIDs, &filter.FirewallGroupIDs)...)
+	}
+	switch {
+	case len(filter.FirewallGroupIDs) > 0:
+		filter.FilterType = "FIREWALL_GROUPS"
+	case filter.Address != "" || filter.Port != "":
+		filter.FilterType = "ADDRESS_AND_PORT"
+	}
+	return filter, diags
+}
+
+func natRuleFilterObject(ctx context.Context, filter *natRuleFilter) (types.Object, diag.Diagnostics) {
+	if filter == nil || filter.FilterType == "" || filter.FilterType == "NONE" {
+		return ut.ObjectNull(&NATRuleFilterModel{})
+	}
+	diags := diag.Diagnostics{}
+	m := &NATRuleFilterModel{
+		Address:          ut.StringOrNull(filter.Address),
+		Port:             ut.StringOrNull(filter.Port),
+		InvertAddress:    types.BoolValue(filter.InvertAddress),
+		InvertPort:       types.BoolValue(filter.InvertPort),
+		FirewallGroupIDs: types.ListNull(types.StringType),
+	}
+	if len(filter.FirewallGroupIDs) > 0 {
+		groups, d := types.ListValueFrom(ctx, types.StringType, filter.FirewallGroupIDs)
+		diags.Append(d...)
+		m.FirewallGroupIDs = groups
+	}
+	obj, d := types.ObjectValueFrom(ctx, m.AttributeTypes(), m)
+	diags.Append(d...)
+	return obj, diags
+}
+
+// NATRuleModel represents the data model for a NAT rule.
+type NATRuleModel struct {
+	base.Model
+	Description    types.String `tfsdk:"description"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Type           types.String `tfsdk:"type"`
+	IPVersion      types.String `tfsdk:"ip_version"`
+	Protocol       types.String `tfsdk:"protocol"`
+	InInterface    types.String `tfsdk:"in_interface"`
+	OutInterface   types.String `tfsdk:"out_interface"`
+	TranslatedIP   types.String `tfsdk:"translated_ip"`
+	TranslatedPort types.String `tfsdk:"translated_port"`
+	RuleIndex      types.Int32  `tfsdk:"rule_index"`
+	Logging        types.Bool   `tfsdk:"logging"`
+	Exclude        types.Bool   `tfsdk:"exclude"`
+	Source         types.Object `tfsdk:"source"`
+	Destination    types.Object `tfsdk:"destination"`
+}
+
+func (m *NATRuleModel) AsUnifiModel(ctx context.Context) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	rule := &natRule{
+		ID:                m.ID.ValueString(),
+		Description:       m.Description.ValueString(),
+		Enabled:           m.Enabled.ValueBool(),
+		Type:              m.Type.ValueString(),
+		IPVersion:         m.IPVersion.ValueString(),
+		Protocol:          m.Protocol.ValueString(),
+		InInterface:       m.InInterface.ValueString(),
+		OutInterface:      m.OutInterface.ValueString(),
+		IPAddress:         m.TranslatedIP.ValueString(),
+		Port:              m.TranslatedPort.ValueString(),
+		RuleIndex:         int(m.RuleIndex.ValueInt32()),
+		Logging:           m.Logging.ValueBool(),
+		Exclude:           m.Exclude.ValueBool(),
+		SourceFilter:      &natRuleFilter{FilterType: "NONE", FirewallGroupIDs: []string{}},
+		DestinationFilter: &natRuleFilter{FilterType: "NONE", FirewallGroupIDs: []string{}},
+	}
+	if ut.IsDefined(m.Source) {
+		var source NATRuleFilterModel
+		diags.Append(m.Source.As(ctx, &source, basetypes.ObjectAsOptions{})...)
+		filter, d := source.asUnifiModel(ctx)
+		diags.Append(d...)
+		rule.SourceFilter = filter
+	}
+	if ut.IsDefined(m.Destination) {
+		var destination NATRuleFilterModel
+		diags.Append(m.Destination.As(ctx, &destination, basetypes.ObjectAsOptions{})...)
+		filter, d := destination.asUnifiModel(ctx)
+		diags.Append(d...)
+		rule.DestinationFilter = filter
+	}
+	return rule, diags
+}
+
+func (m *NATRuleModel) Merge(ctx context.Context, other interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	rule, ok := other.(*natRule)
+	if !ok {
+		diags.AddError("Cannot merge", "Cannot merge type that is not *natRule")
+		return diags
+	}
+	m.ID = types.StringValue(rule.ID)
+	m.Description = ut.StringOrNull(rule.Description)
+	m.Enabled = types.BoolValue(rule.Enabled)
+	m.Type = types.StringValue(rule.Type)
+	m.IPVersion = types.StringValue(rule.IPVersion)
+	m.Protocol = types.StringValue(rule.Protocol)
+	m.InInterface = ut.StringOrNull(rule.InInterface)
+	m.OutInterface = ut.StringOrNull(rule.OutInterface)
+	m.TranslatedIP = ut.StringOrNull(rule.IPAddress)
+	m.TranslatedPort = ut.StringOrNull(rule.Port)
+	m.RuleIndex = types.Int32Value(int32(rule.RuleIndex))
+	m.Logging = types.BoolValue(rule.Logging)
+	m.Exclude = types.BoolValue(rule.Exclude)
+
+	source, d := natRuleFilterObject(ctx, rule.SourceFilter)
+	diags.Append(d...)
+	m.Source = source
+	destination, d := natRuleFilterObject(ctx, rule.DestinationFilter)
+	diags.Append(d...)
+	m.Destination = destination
+	return diags
+}
+
+type natRuleResource struct {
+	*base.GenericResource[*NATRuleModel]
+}
+
+// NewNATRuleResource creates a new instance of the NAT rule resource.
+func NewNATRuleResource() resource.Resource {
+	return &natRuleResource{
+		GenericResource: base.NewGenericResource(
+			"unifi_nat_rule",
+			func() *NATRuleModel { return &NATRuleModel{} },
+			base.ResourceFunctions{
+				Read: func(ctx context.Context, client *base.Client, site, id string) (interface{}, error) {
+					return getNATRule(ctx, client, site, id)
+				},
+				Create: func(ctx context.Context, client *base.Client, site string, model interface{}) (interface{}, error) {
+					m, ok := model.(*natRule)
+					if !ok {
+						return nil, fmt.Errorf("unexpected model type: %T", model)
+					}
+					return createNATRule(ctx, client, site, m)
+				},
+				Update: func(ctx context.Context, client *base.Client, site string, model interface{}) (interface{}, error) {
+					m, ok := model.(*natRule)
+					if !ok {
+						return nil, fmt.Errorf("unexpected model type: %T", model)
+					}
+					return updateNATRule(ctx, client, site, m)
+				},
+				Delete: func(ctx context.Context, client *base.Client, site, id string) error {
+					return deleteNATRule(ctx, client, site, id)
+				},
+			},
+		),
+	}
+}
+
+func (r *natRuleResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.RequiredSimpleTogetherIf("type", types.StringValue("SNAT"), "translated_ip", "out_interface"),
+		validators.RequiredSimpleTogetherIf("type", types.StringValue("DNAT"), "translated_ip", "in_interface"),
+		validators.RequiredSimpleTogetherIf("type", types.StringValue("MASQUERADE"), "out_interface"),
+		validators.RequiredNoneIf(path.MatchRoot("type"), types.StringValue("MASQUERADE"), path.MatchRoot("translated_ip"), path.MatchRoot("translated_port")),
+	}
+}
+
+// ValidateConfig rejects ports on rules not limited to TCP or UDP, as only
+// those protocols have ports to match or translate.
+func (r *natRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var protocol types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("protocol"), &protocol)...)
+	if protocol.IsUnknown() || (!protocol.IsNull() && protocol.ValueString() != "all") {
+		return
+	}
+	for _, p := range []path.Path{
+		path.Root("translated_port"),
+		path.Root("source").AtName("port"),
+		path.Root("destination").AtName("port"),
+	} {
+		var port types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &port)...)
+		if ut.IsDefined(port) {
+			resp.Diagnostics.AddAttributeError(p, "Invalid port",
+				"Ports can only be set when `protocol` is one of `tcp`, `udp` or `tcp_udp`.")
+		}
+	}
+}
+
+// ModifyPlan gates the resource on the minimum controller version that exposes
+// NAT rule management.
+func (r *natRuleResource) ModifyPlan(_ context.Context, _ resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(r.RequireMinVersion("9.0")...)
+}
+
+func natRuleFilterAttributes(target string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"address": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The %s IP address or CIDR to match.", target),
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.Any(
+					validators.IPv4(),
+					validators.IPv6(),
+					validators.CIDR(),
+				),
+				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("firewall_group_ids")),
+				stringvalidator.AtLeastOneOf(
+					path.MatchRelative().AtParent().AtName("port"),
+					path.MatchRelative().AtParent().AtName("firewall_group_ids"),
+				),
+			},
+		},
+		"port": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The %s port, port range or comma-separated list of ports to match, e.g. `80`, `8000-8010` or `80,443`. "+
+				"Requires `protocol` to be `tcp`, `udp` or `tcp_udp`.", target),
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(validators.PortRangeRegexp, "must be a port, port range or comma-separated list of ports"),
+			},
+		},
+		"invert_address": schema.BoolAttribute{
+			MarkdownDescription: fmt.Sprintf("Whether to match traffic whose %s address does not match `address`.", target),
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"invert_port": schema.BoolAttribute{
+			MarkdownDescription: fmt.Sprintf("Whether to match traffic whose %s port does not match `port`.", target),
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"firewall_group_ids": schema.ListAttribute{
+			MarkdownDescription: fmt.Sprintf("IDs of the firewall groups of %s addresses or ports to match, instead of `address` and `port`.", target),
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("address"),
+					path.MatchRelative().AtParent().AtName("port"),
+				),
+			},
+		},
+	}
+}
+
+func (r *natRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_nat_rule` resource manages NAT rules of the UniFi gateway, exposed in the controller UI under **Settings → Routing → NAT**.\n\n" +
+			"Unlike `unifi_port_forward`, which only covers simple destination NAT from the WAN, it supports:\n" +
+			"  * `MASQUERADE` - source NAT to the address of the outgoing interface\n" +
+			"  * `SNAT` - source NAT to a specific address\n" +
+			"  * `DNAT` - destination NAT, optionally restricted to specific sources\n\n" +
+			"A 1:1 NAT is configured as a pair of `DNAT` and `SNAT` rules, translating the public address to the private one and back.\n\n" +
+			"!> This resource requires UniFi Network 9.0 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id":   ut.ID(),
+			"site": ut.SiteAttribute(),
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the NAT rule.",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the NAT rule is enabled.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the NAT rule. Must be one of `MASQUERADE`, `SNAT` or `DNAT`. " +
+					"`MASQUERADE` and `SNAT` require `out_interface`, `DNAT` requires `in_interface`, and `SNAT` and `DNAT` require `translated_ip`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("MASQUERADE", "SNAT", "DNAT"),
+				},
+			},
+			"ip_version": schema.StringAttribute{
+				MarkdownDescription: "The IP version of the traffic to match. Must be one of `IPV4` or `IPV6`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("IPV4"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("IPV4", "IPV6"),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The protocol of the traffic to match. Must be one of `all`, `tcp`, `udp` or `tcp_udp`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("all"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "tcp", "udp", "tcp_udp"),
+				},
+			},
+			"in_interface": schema.StringAttribute{
+				MarkdownDescription: "The ID of the network or WAN the traffic enters the gateway through.",
+				Optional:            true,
+			},
+			"out_interface": schema.StringAttribute{
+				MarkdownDescription: "The ID of the network or WAN the traffic leaves the gateway through.",
+				Optional:            true,
+			},
+			"translated_ip": schema.StringAttribute{
+				MarkdownDescription: "The address the source (`SNAT`) or destination (`DNAT`) address is translated to. Cannot be set for `MASQUERADE` rules.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.Any(
+						validators.IPv4(),
+						validators.IPv6(),
+					),
+				},
+			},
+			"translated_port": schema.StringAttribute{
+				MarkdownDescription: "The port or port range the source (`SNAT`) or destination (`DNAT`) port is translated to. " +
+					"Requires `protocol` to be `tcp`, `udp` or `tcp_udp`. Cannot be set for `MASQUERADE` rules.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(validators.PortRangeRegexp, "must be a port or port range"),
+				},
+			},
+			"rule_index": schema.Int32Attribute{
+				MarkdownDescription: "The position of the rule, as rules are evaluated in ascending order. Assigned by the controller if not set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"logging": schema.BoolAttribute{
+				MarkdownDescription: "Whether to generate syslog entries when traffic is matched.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"exclude": schema.BoolAttribute{
+				MarkdownDescription: "Whether to exclude the matched traffic from NAT, e.g. to keep a masquerade rule from translating traffic to a VPN.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"source": schema.SingleNestedAttribute{
+				MarkdownDescription: "The source of the traffic to match. All sources are matched if not set.",
+				Optional:            true,
+				Attributes:          natRuleFilterAttributes("source"),
+			},
+			"destination": schema.SingleNestedAttribute{
+				MarkdownDescription: "The destination of the traffic to match. All destinations are matched if not set.",
+				Optional:            true,
+				Attributes:          natRuleFilterAttributes("destination"),
+			},
+		},
+	}
+}
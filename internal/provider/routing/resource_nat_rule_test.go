@@ -0,0 +1,78 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+func TestNATRuleModelRoundTrip(t *testing.T) {
+	ctx := t.Context()
+	rule := &natRule{
+		ID:           "abc",
+		Description:  "dnat web",
+		Enabled:      true,
+		Type:         "DNAT",
+		IPVersion:    "IPV4",
+		Protocol:     "tcp",
+		InInterface:  "wan1",
+		IPAddress:    "10.0.0.10",
+		Port:         "8080",
+		RuleIndex:    3,
+		SourceFilter: &natRuleFilter{FilterType: "ADDRESS_AND_PORT", Address: "203.0.113.0/24", FirewallGroupIDs: []string{}},
+		DestinationFilter: &natRuleFilter{
+			FilterType:       "FIREWALL_GROUPS",
+			FirewallGroupIDs: []string{"group1"},
+		},
+	}
+
+	m := &NATRuleModel{Model: base.Model{Site: ut.NewSiteValue("default")}}
+	require.False(t, m.Merge(ctx, rule).HasError())
+	assert.Equal(t, "10.0.0.10", m.TranslatedIP.ValueString())
+	assert.True(t, m.OutInterface.IsNull())
+	assert.Equal(t, int32(3), m.RuleIndex.ValueInt32())
+
+	out, diags := m.AsUnifiModel(ctx)
+	require.False(t, diags.HasError())
+	assert.Equal(t, rule, out)
+}
+
+func TestNATRuleModelFilters(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("NoFilterIsNull", func(t *testing.T) {
+		m := &NATRuleModel{}
+		require.False(t, m.Merge(ctx, &natRule{Type: "MASQUERADE", SourceFilter: &natRuleFilter{FilterType: "NONE"}}).HasError())
+		assert.True(t, m.Source.IsNull())
+		assert.True(t, m.Destination.IsNull())
+
+		out, diags := m.AsUnifiModel(ctx)
+		require.False(t, diags.HasError())
+		assert.Equal(t, "NONE", out.(*natRule).SourceFilter.FilterType)
+		assert.Equal(t, "NONE", out.(*natRule).DestinationFilter.FilterType)
+	})
+
+	t.Run("PortOnlyIsAddressAndPort", func(t *testing.T) {
+		filter := &NATRuleFilterModel{
+			Address:          types.StringNull(),
+			Port:             types.StringValue("443"),
+			InvertAddress:    types.BoolValue(false),
+			InvertPort:       types.BoolValue(true),
+			FirewallGroupIDs: types.ListNull(types.StringType),
+		}
+		out, diags := filter.asUnifiModel(ctx)
+		require.False(t, diags.HasError())
+		assert.Equal(t, "ADDRESS_AND_PORT", out.FilterType)
+		assert.True(t, out.InvertPort)
+	})
+}
+
+func TestNATRuleMergeWrongType(t *testing.T) {
+	m := &NATRuleModel{}
+	assert.True(t, m.Merge(t.Context(), "not a rule").HasError())
+}
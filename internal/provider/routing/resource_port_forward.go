@@ -40,11 +40,12 @@ func ResourcePortForward() *schema.Resource {
 				Computed:    true,
 			},
 			"site": {
-				Description: "The name of the UniFi site where the port forwarding rule should be created. If not specified, the default site will be used.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				ForceNew:    true,
+				Description:      "The name of the UniFi site where the port forwarding rule should be created. If not specified, the default site will be used.",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: utils.SiteDiffSuppressFunc,
 			},
 			"dst_port": {
 				Description:  "The external port(s) that will be forwarded. Can be a single port (e.g., '80') or a port range (e.g., '8080:8090').",
@@ -127,9 +128,9 @@ func resourcePortForwardCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	req := resourcePortForwardGetResourceData(d)
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	resp, err := c.CreatePortForward(ctx, site, req)
 	if err != nil {
@@ -208,9 +209,9 @@ func resourcePortForwardRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	resp, err := c.GetPortForward(ctx, site, id)
 	if errors.Is(err, unifi.ErrNotFound) {
@@ -234,9 +235,9 @@ func resourcePortForwardUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	req.ID = d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	req.SiteID = site
 
@@ -266,11 +267,11 @@ func resourcePortForwardDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	err := c.DeletePortForward(ctx, site, id)
+	err = c.DeletePortForward(ctx, site, id)
 	return diag.FromErr(err)
 }
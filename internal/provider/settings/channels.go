@@ -0,0 +1,131 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+)
+
+// Radio bands, as named by the controller.
+const (
+	radioNg = "ng" // 2.4 GHz
+	radioNa = "na" // 5 GHz
+	radio6e = "6e" // 6 GHz
+)
+
+// channelList is a list of channels. Depending on its version, the controller
+// returns channels as numbers or as strings.
+type channelList []int
+
+func (l *channelList) UnmarshalJSON(b []byte) error {
+	var raw []interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	channels := make(channelList, 0, len(raw))
+	for _, r := range raw {
+		switch v := r.(type) {
+		case float64:
+			channels = append(channels, int(v))
+		case string:
+			c, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("invalid channel %q: %w", v, err)
+			}
+			channels = append(channels, c)
+		default:
+			return fmt.Errorf("invalid channel %v", r)
+		}
+	}
+	*l = channels
+	return nil
+}
+
+// regulatoryDomain holds the channels radios of a site may use in the country
+// the site is set to with `unifi_setting_country`.
+type regulatoryDomain struct {
+	Name          string      `json:"name"`
+	ChannelsNg    channelList `json:"channels_ng"`
+	ChannelsNa    channelList `json:"channels_na"`
+	ChannelsNaDfs channelList `json:"channels_na_dfs"`
+	Channels6e    channelList `json:"channels_6e"`
+}
+
+func getRegulatoryDomain(ctx context.Context, c *base.Client, site string) (*regulatoryDomain, error) {
+	var resp base.RestResponse[regulatoryDomain]
+	if err := c.Do(ctx, http.MethodGet, fmt.Sprintf("s/%s/stat/current-channel", site), nil, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("no channels returned for site %q", site)
+	}
+	return &resp.Data[0], nil
+}
+
+// planRegulatoryDomain returns the regulatory domain of the site of a planned
+// resource. When it cannot be read, e.g. as the site is created in the same
+// plan, a warning is returned instead of the domain, so validation is skipped.
+func planRegulatoryDomain(ctx context.Context, c *base.Client, res base.SiteAware) (*regulatoryDomain, diag.Diagnostics) {
+	site, diags := c.ResolveSite(res)
+	if diags.HasError() {
+		return nil, diags
+	}
+	domain, err := getRegulatoryDomain(ctx, c, site)
+	if err != nil {
+		diags.AddWarning("Unable to validate channels", fmt.Sprintf("The channels allowed on site %q cannot be read: %s", site, err))
+		return nil, diags
+	}
+	return domain, diags
+}
+
+// channels returns the channels allowed on a band, including DFS channels.
+func (r *regulatoryDomain) channels(radio string) []int {
+	switch radio {
+	case radioNg:
+		return r.ChannelsNg
+	case radioNa:
+		return append(append([]int{}, r.ChannelsNa...), r.ChannelsNaDfs...)
+	case radio6e:
+		return r.Channels6e
+	}
+	return nil
+}
+
+// isDFS reports whether a 5 GHz channel requires dynamic frequency selection.
+func (r *regulatoryDomain) isDFS(channel int) bool {
+	return slices.Contains(r.ChannelsNaDfs, channel)
+}
+
+// validateChannels adds an error to the attribute for every channel of the set
+// not allowed on the band in the country of the site. Unknown sets are skipped.
+func (r *regulatoryDomain) validateChannels(ctx context.Context, attr string, radio string, set types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if set.IsNull() || set.IsUnknown() {
+		return diags
+	}
+	var channels []int64
+	diags.Append(set.ElementsAs(ctx, &channels, true)...)
+	allowed := r.channels(radio)
+	for _, c := range channels {
+		if !slices.Contains(allowed, int(c)) {
+			diags.AddAttributeError(path.Root(attr), "Invalid channel",
+				fmt.Sprintf("Channel %d is not allowed on the %s band in %s, allowed channels are %v.", c, bandNames[radio], r.Name, allowed))
+		}
+	}
+	return diags
+}
+
+var bandNames = map[string]string{
+	radioNg: "2.4 GHz",
+	radioNa: "5 GHz",
+	radio6e: "6 GHz",
+}
@@ -0,0 +1,50 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelList_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var domain regulatoryDomain
+	err := json.Unmarshal([]byte(`{"name":"Poland","channels_ng":[1,6,11],"channels_na":["36","40"],"channels_na_dfs":[52]}`), &domain)
+	require.NoError(t, err)
+	assert.Equal(t, channelList{1, 6, 11}, domain.ChannelsNg)
+	assert.Equal(t, channelList{36, 40}, domain.ChannelsNa)
+	assert.Equal(t, channelList{52}, domain.ChannelsNaDfs)
+	assert.Empty(t, domain.Channels6e)
+
+	err = json.Unmarshal([]byte(`{"channels_ng":["auto"]}`), &domain)
+	assert.Error(t, err)
+}
+
+func TestRegulatoryDomain_ValidateChannels(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	domain := &regulatoryDomain{
+		Name:          "Poland",
+		ChannelsNg:    channelList{1, 6, 11},
+		ChannelsNa:    channelList{36, 40},
+		ChannelsNaDfs: channelList{52, 56},
+	}
+
+	valid, _ := types.SetValueFrom(ctx, types.Int64Type, []int64{36, 52})
+	assert.False(t, domain.validateChannels(ctx, "excluded_channels_na", radioNa, valid).HasError())
+
+	invalid, _ := types.SetValueFrom(ctx, types.Int64Type, []int64{1, 14})
+	diags := domain.validateChannels(ctx, "excluded_channels_ng", radioNg, invalid)
+	require.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Detail(), "Channel 14 is not allowed on the 2.4 GHz band in Poland")
+
+	assert.False(t, domain.validateChannels(ctx, "excluded_channels_6e", radio6e, types.SetUnknown(types.Int64Type)).HasError())
+	assert.True(t, domain.isDFS(56))
+	assert.False(t, domain.isDFS(36))
+}
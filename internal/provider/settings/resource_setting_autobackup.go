@@ -0,0 +1,148 @@
+package settings
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+// cronExpression matches a cron expression of five fields.
+var cronExpression = regexp.MustCompile(`^\S+(\s+\S+){4}$`)
+
+// superMgmtAutobackup holds the automatic backup fields of the controller-wide
+// `super_mgmt` setting. The controller only updates the fields sent, so the
+// other management settings are left alone.
+type superMgmtAutobackup struct {
+	ID                 string `json:"_id,omitempty"`
+	Key                string `json:"key"`
+	AutobackupEnabled  bool   `json:"autobackup_enabled"`
+	AutobackupCronExpr string `json:"autobackup_cron_expr,omitempty"`
+	AutobackupMaxFiles int    `json:"autobackup_max_files,omitempty"`
+	AutobackupDays     *int   `json:"autobackup_days,omitempty"`
+	AutobackupTimezone string `json:"autobackup_timezone,omitempty"`
+}
+
+// autobackupModel represents the data model for the automatic backups of the
+// controller.
+type autobackupModel struct {
+	superSettingModel
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	CronExpr types.String `tfsdk:"cron_expr"`
+	MaxFiles types.Int32  `tfsdk:"max_files"`
+	Days     types.Int32  `tfsdk:"days"`
+	Timezone types.String `tfsdk:"timezone"`
+}
+
+func (d *autobackupModel) AsUnifiModel(_ context.Context) (interface{}, diag.Diagnostics) {
+	model := &superMgmtAutobackup{
+		ID:                 d.ID.ValueString(),
+		Key:                "super_mgmt",
+		AutobackupEnabled:  d.Enabled.ValueBool(),
+		AutobackupCronExpr: d.CronExpr.ValueString(),
+		AutobackupMaxFiles: int(d.MaxFiles.ValueInt32()),
+		AutobackupTimezone: d.Timezone.ValueString(),
+	}
+	// Zero days is a valid value, so it is only left out when not configured.
+	if !d.Days.IsNull() && !d.Days.IsUnknown() {
+		days := int(d.Days.ValueInt32())
+		model.AutobackupDays = &days
+	}
+	return model, nil
+}
+
+func (d *autobackupModel) Merge(_ context.Context, other interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model, ok := other.(*superMgmtAutobackup)
+	if !ok {
+		diags.AddError("Cannot merge", "Cannot merge type that is not *superMgmtAutobackup")
+		return diags
+	}
+
+	d.ID = types.StringValue(model.ID)
+	d.Enabled = types.BoolValue(model.AutobackupEnabled)
+	d.CronExpr = types.StringValue(model.AutobackupCronExpr)
+	d.MaxFiles = types.Int32Value(int32(model.AutobackupMaxFiles))
+	d.Days = types.Int32Value(0)
+	if model.AutobackupDays != nil {
+		d.Days = types.Int32Value(int32(*model.AutobackupDays))
+	}
+	d.Timezone = types.StringValue(model.AutobackupTimezone)
+	return diags
+}
+
+var (
+	_ base.ResourceModel               = &autobackupModel{}
+	_ resource.Resource                = &autobackupResource{}
+	_ resource.ResourceWithConfigure   = &autobackupResource{}
+	_ resource.ResourceWithImportState = &autobackupResource{}
+)
+
+type autobackupResource struct {
+	*superSettingResource[*autobackupModel]
+}
+
+func (r *autobackupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_setting_autobackup` resource manages the schedule and retention of the automatic backups of the UniFi controller.\n\n" +
+			"This is a controller-wide setting shared by all sites. Use the `unifi_backup` data source to take a backup on demand.",
+		Attributes: map[string]schema.Attribute{
+			"id": ut.ID(),
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the controller backs itself up automatically.",
+				Required:            true,
+			},
+			"cron_expr": schema.StringAttribute{
+				MarkdownDescription: "The schedule of automatic backups as a cron expression of five fields, e.g. `0 1 * * 1` for every Monday at 1 AM.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cronExpression, "must be a cron expression of five fields"),
+				},
+			},
+			"max_files": schema.Int32Attribute{
+				MarkdownDescription: "The number of automatic backups to keep. Older backups are deleted.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"days": schema.Int32Attribute{
+				MarkdownDescription: "The number of days of history, like statistics and events, to include in backups. `0` backs up settings only.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "The timezone `cron_expr` is evaluated in, e.g. `Europe/Warsaw`.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// NewAutobackupResource creates a new instance of the automatic backup setting
+// resource.
+func NewAutobackupResource() resource.Resource {
+	r := &autobackupResource{}
+	r.superSettingResource = newSuperSettingResource[*autobackupModel, superMgmtAutobackup](
+		"unifi_setting_autobackup",
+		func() *autobackupModel { return &autobackupModel{} },
+		"super_mgmt",
+	)
+	return r
+}
@@ -0,0 +1,50 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutobackupModel_AsUnifiModel(t *testing.T) {
+	t.Parallel()
+
+	model := autobackupModel{
+		Enabled:  types.BoolValue(true),
+		CronExpr: types.StringValue("0 1 * * 1"),
+		MaxFiles: types.Int32Value(7),
+		Days:     types.Int32Value(0),
+		Timezone: types.StringUnknown(),
+	}
+
+	unifiModel, diags := model.AsUnifiModel(context.Background())
+	require.False(t, diags.HasError())
+	mgmt, ok := unifiModel.(*superMgmtAutobackup)
+	require.True(t, ok)
+
+	assert.Equal(t, "super_mgmt", mgmt.Key)
+	assert.True(t, mgmt.AutobackupEnabled)
+	assert.Equal(t, "0 1 * * 1", mgmt.AutobackupCronExpr)
+	assert.Equal(t, 7, mgmt.AutobackupMaxFiles)
+	// Backing up settings only is sent explicitly.
+	require.NotNil(t, mgmt.AutobackupDays)
+	assert.Equal(t, 0, *mgmt.AutobackupDays)
+	assert.Empty(t, mgmt.AutobackupTimezone)
+
+	model.Days = types.Int32Unknown()
+	unifiModel, _ = model.AsUnifiModel(context.Background())
+	mgmt, _ = unifiModel.(*superMgmtAutobackup)
+	assert.Nil(t, mgmt.AutobackupDays)
+}
+
+func TestCronExpression(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, cronExpression.MatchString("0 1 * * 1"))
+	assert.True(t, cronExpression.MatchString("*/15 0-6 1,15 * MON"))
+	assert.False(t, cronExpression.MatchString("0 1 * *"))
+	assert.False(t, cronExpression.MatchString("0 1 * * 1 2020"))
+}
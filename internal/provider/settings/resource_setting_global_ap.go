@@ -0,0 +1,233 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+var txPowerModes = []string{"auto", "high", "medium", "low"}
+
+// globalApSetting is the `global_ap` setting of a site, holding the defaults
+// of the access points of the site. Fields are only sent when configured, as
+// the controller leaves fields not sent unchanged.
+type globalApSetting struct {
+	ID                      string  `json:"_id,omitempty"`
+	Key                     string  `json:"key"`
+	NgChannelSize           *int    `json:"ng_channel_size,omitempty"`
+	NgTxPowerMode           *string `json:"ng_tx_power_mode,omitempty"`
+	NaChannelSize           *int    `json:"na_channel_size,omitempty"`
+	NaTxPowerMode           *string `json:"na_tx_power_mode,omitempty"`
+	SixeChannelSize         *int    `json:"6e_channel_size,omitempty"`
+	SixeTxPowerMode         *string `json:"6e_tx_power_mode,omitempty"`
+	SixeEnabled             *bool   `json:"6e_enabled,omitempty"`
+	MeshEnabled             *bool   `json:"mesh_enabled,omitempty"`
+	RoamingAssistantEnabled *bool   `json:"roaming_assistant_enabled,omitempty"`
+	BandSteeringMode        *string `json:"band_steering_mode,omitempty"`
+}
+
+// globalApModel represents the data model for the access point defaults of a
+// site.
+type globalApModel struct {
+	base.Model
+	ChannelWidthNg          types.Int64  `tfsdk:"channel_width_ng"`
+	TxPowerModeNg           types.String `tfsdk:"tx_power_mode_ng"`
+	ChannelWidthNa          types.Int64  `tfsdk:"channel_width_na"`
+	TxPowerModeNa           types.String `tfsdk:"tx_power_mode_na"`
+	ChannelWidth6e          types.Int64  `tfsdk:"channel_width_6e"`
+	TxPowerMode6e           types.String `tfsdk:"tx_power_mode_6e"`
+	Wifi6eEnabled           types.Bool   `tfsdk:"wifi6e_enabled"`
+	MeshEnabled             types.Bool   `tfsdk:"mesh_enabled"`
+	RoamingAssistantEnabled types.Bool   `tfsdk:"roaming_assistant_enabled"`
+	BandSteeringMode        types.String `tfsdk:"band_steering_mode"`
+}
+
+func intPtrOrNil(v types.Int64) *int {
+	if !ut.IsDefined(v) {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
+}
+
+func stringPtrOrNil(v types.String) *string {
+	if !ut.IsDefined(v) {
+		return nil
+	}
+	return v.ValueStringPointer()
+}
+
+func boolPtrOrNil(v types.Bool) *bool {
+	if !ut.IsDefined(v) {
+		return nil
+	}
+	return v.ValueBoolPointer()
+}
+
+func int64OrNull(v *int) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
+
+func (d *globalApModel) AsUnifiModel(_ context.Context) (interface{}, diag.Diagnostics) {
+	return &globalApSetting{
+		ID:                      d.ID.ValueString(),
+		Key:                     "global_ap",
+		NgChannelSize:           intPtrOrNil(d.ChannelWidthNg),
+		NgTxPowerMode:           stringPtrOrNil(d.TxPowerModeNg),
+		NaChannelSize:           intPtrOrNil(d.ChannelWidthNa),
+		NaTxPowerMode:           stringPtrOrNil(d.TxPowerModeNa),
+		SixeChannelSize:         intPtrOrNil(d.ChannelWidth6e),
+		SixeTxPowerMode:         stringPtrOrNil(d.TxPowerMode6e),
+		SixeEnabled:             boolPtrOrNil(d.Wifi6eEnabled),
+		MeshEnabled:             boolPtrOrNil(d.MeshEnabled),
+		RoamingAssistantEnabled: boolPtrOrNil(d.RoamingAssistantEnabled),
+		BandSteeringMode:        stringPtrOrNil(d.BandSteeringMode),
+	}, nil
+}
+
+func (d *globalApModel) Merge(_ context.Context, other interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model, ok := other.(*globalApSetting)
+	if !ok {
+		diags.AddError("Cannot merge", "Cannot merge type that is not *globalApSetting")
+		return diags
+	}
+
+	d.ID = types.StringValue(model.ID)
+	d.ChannelWidthNg = int64OrNull(model.NgChannelSize)
+	d.TxPowerModeNg = types.StringPointerValue(model.NgTxPowerMode)
+	d.ChannelWidthNa = int64OrNull(model.NaChannelSize)
+	d.TxPowerModeNa = types.StringPointerValue(model.NaTxPowerMode)
+	d.ChannelWidth6e = int64OrNull(model.SixeChannelSize)
+	d.TxPowerMode6e = types.StringPointerValue(model.SixeTxPowerMode)
+	d.Wifi6eEnabled = types.BoolPointerValue(model.SixeEnabled)
+	d.MeshEnabled = types.BoolPointerValue(model.MeshEnabled)
+	d.RoamingAssistantEnabled = types.BoolPointerValue(model.RoamingAssistantEnabled)
+	d.BandSteeringMode = types.StringPointerValue(model.BandSteeringMode)
+	return diags
+}
+
+var (
+	_ base.ResourceModel               = &globalApModel{}
+	_ resource.Resource                = &globalApResource{}
+	_ resource.ResourceWithConfigure   = &globalApResource{}
+	_ resource.ResourceWithImportState = &globalApResource{}
+	_ resource.ResourceWithModifyPlan  = &globalApResource{}
+)
+
+type globalApResource struct {
+	*base.GenericResource[*globalApModel]
+}
+
+// ModifyPlan rejects enabling the 6 GHz band on sites whose country does not
+// allow it.
+func (r *globalApResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.GetClient() == nil {
+		return
+	}
+	var plan globalApModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.Wifi6eEnabled.ValueBool() {
+		return
+	}
+	domain, diags := planRegulatoryDomain(ctx, r.GetClient(), &plan)
+	resp.Diagnostics.Append(diags...)
+	if domain != nil && len(domain.Channels6e) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("wifi6e_enabled"), "6 GHz not allowed",
+			fmt.Sprintf("The 6 GHz band is not allowed in %s, the country of the site.", domain.Name))
+	}
+}
+
+func (r *globalApResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	channelWidth := func(band string, widths ...int64) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("The default channel width in MHz of %s radios.", band),
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Int64{
+				int64validator.OneOf(widths...),
+			},
+		}
+	}
+	txPowerMode := func(band string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The default transmit power of %s radios. Valid values are `auto`, `high`, `medium` and `low`.", band),
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(txPowerModes...),
+			},
+		}
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_setting_global_ap` resource manages the defaults of the access points of a UniFi site, " +
+			"like channel widths, transmit power, 6 GHz, meshing, roaming assistant and band steering.\n\n" +
+			"Attributes not configured are left as set on the controller.",
+		Attributes: map[string]schema.Attribute{
+			"id":               ut.ID(),
+			"site":             ut.SiteAttribute(),
+			"channel_width_ng": channelWidth("2.4 GHz", 20, 40),
+			"tx_power_mode_ng": txPowerMode("2.4 GHz"),
+			"channel_width_na": channelWidth("5 GHz", 20, 40, 80, 160),
+			"tx_power_mode_na": txPowerMode("5 GHz"),
+			"channel_width_6e": channelWidth("6 GHz", 20, 40, 80, 160, 320),
+			"tx_power_mode_6e": txPowerMode("6 GHz"),
+			"wifi6e_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether access points broadcast on the 6 GHz band. The country of the site, set with `unifi_setting_country`, must allow it.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"mesh_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether access points may connect wirelessly to other access points when their uplink is lost.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"roaming_assistant_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether access points disconnect clients with a weak signal, so they roam to a closer access point.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"band_steering_mode": schema.StringAttribute{
+				MarkdownDescription: "The default band steering of access points. Valid values are `off`, `equal` and `prefer_5g`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("off", "equal", "prefer_5g"),
+				},
+			},
+		},
+	}
+}
+
+// NewGlobalApResource creates a new instance of the global access point
+// setting resource.
+func NewGlobalApResource() resource.Resource {
+	r := &globalApResource{}
+	r.GenericResource = NewSettingResource(
+		"unifi_setting_global_ap",
+		func() *globalApModel { return &globalApModel{} },
+		func(ctx context.Context, client *base.Client, site string) (interface{}, error) {
+			return base.GetSetting[globalApSetting](ctx, client, site, "global_ap")
+		},
+		func(ctx context.Context, client *base.Client, site string, body interface{}) (interface{}, error) {
+			b, _ := body.(*globalApSetting)
+			return base.UpdateSetting(ctx, client, site, "global_ap", b)
+		},
+	)
+	return r
+}
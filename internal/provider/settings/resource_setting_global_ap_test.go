@@ -0,0 +1,46 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalApModel_AsUnifiModel(t *testing.T) {
+	t.Parallel()
+
+	model := globalApModel{
+		ChannelWidthNg:          types.Int64Value(20),
+		TxPowerModeNg:           types.StringValue("low"),
+		ChannelWidthNa:          types.Int64Unknown(),
+		TxPowerModeNa:           types.StringUnknown(),
+		ChannelWidth6e:          types.Int64Null(),
+		TxPowerMode6e:           types.StringNull(),
+		Wifi6eEnabled:           types.BoolValue(false),
+		MeshEnabled:             types.BoolUnknown(),
+		RoamingAssistantEnabled: types.BoolValue(true),
+		BandSteeringMode:        types.StringValue("prefer_5g"),
+	}
+
+	unifiModel, diags := model.AsUnifiModel(context.Background())
+	require.False(t, diags.HasError())
+	setting, ok := unifiModel.(*globalApSetting)
+	require.True(t, ok)
+
+	assert.Equal(t, "global_ap", setting.Key)
+	require.NotNil(t, setting.NgChannelSize)
+	assert.Equal(t, 20, *setting.NgChannelSize)
+	assert.Equal(t, "low", *setting.NgTxPowerMode)
+	// Attributes not configured are not sent.
+	assert.Nil(t, setting.NaChannelSize)
+	assert.Nil(t, setting.NaTxPowerMode)
+	assert.Nil(t, setting.SixeChannelSize)
+	assert.Nil(t, setting.MeshEnabled)
+	require.NotNil(t, setting.SixeEnabled)
+	assert.False(t, *setting.SixeEnabled)
+	assert.True(t, *setting.RoamingAssistantEnabled)
+	assert.Equal(t, "prefer_5g", *setting.BandSteeringMode)
+}
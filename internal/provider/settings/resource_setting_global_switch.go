@@ -346,7 +346,11 @@ func (r *globalSwitchResource) write(ctx context.Context, plan tfsdk.Plan, state
 	if diags.HasError() {
 		return
 	}
-	site := client.ResolveSite(&model)
+	site, d := client.ResolveSite(&model)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
 
 	cur, err := client.GetSettingGlobalSwitch(ctx, site)
 	cur, abort := decideBaseGlobalSwitch(cur, err)
@@ -406,7 +410,11 @@ func (r *globalSwitchResource) Read(ctx context.Context, req resource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	site := client.ResolveSite(&state)
+	site, diags := client.ResolveSite(&state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	cur, err := client.GetSettingGlobalSwitch(ctx, site)
 	if err != nil {
@@ -435,7 +443,7 @@ func (r *globalSwitchResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 
-	_, site := base.ImportIDWithSite(req, resp)
+	_, site := base.ImportIDWithSite(r.GetClient(), req, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -0,0 +1,341 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+// radioAIExcludedChannel is a channel Radio AI does not assign to radios.
+type radioAIExcludedChannel struct {
+	Channel      int    `json:"channel"`
+	ChannelWidth int    `json:"channel_width"`
+	Radio        string `json:"radio"`
+}
+
+// radioAISetting is the `radio_ai` setting of a site, which configures the
+// nightly channel optimization of the access points of the site.
+type radioAISetting struct {
+	ID                string                   `json:"_id,omitempty"`
+	Key               string                   `json:"key"`
+	Enabled           bool                     `json:"enabled"`
+	CronExpr          string                   `json:"cron_expr,omitempty"`
+	Radios            []string                 `json:"radios,omitempty"`
+	Optimize          []string                 `json:"optimize,omitempty"`
+	ChannelsBlacklist []radioAIExcludedChannel `json:"channels_blacklist"`
+	HtModesNg         []int                    `json:"ht_modes_ng,omitempty"`
+	HtModesNa         []int                    `json:"ht_modes_na,omitempty"`
+	HtModes6e         []int                    `json:"ht_modes_6e,omitempty"`
+
+	// excludeDFS makes the updater exclude all DFS channels of the country of
+	// the site.
+	excludeDFS bool
+	// dfsChannels are the DFS channels of the country of the site, set by the
+	// getter and the updater.
+	dfsChannels []int
+}
+
+// excludedChannels returns the excluded channels of a band. When all DFS
+// channels are excluded, they are left out, as they are represented by `dfs`.
+func (s *radioAISetting) excludedChannels(radio string) []int {
+	dfsExcluded := s.dfsExcluded()
+	channels := []int{}
+	for _, c := range s.ChannelsBlacklist {
+		if c.Radio != radio || (radio == radioNa && dfsExcluded && slices.Contains(s.dfsChannels, c.Channel)) {
+			continue
+		}
+		channels = append(channels, c.Channel)
+	}
+	return channels
+}
+
+// dfsExcluded reports whether all DFS channels of the country are excluded.
+func (s *radioAISetting) dfsExcluded() bool {
+	if len(s.dfsChannels) == 0 {
+		return false
+	}
+	for _, dfs := range s.dfsChannels {
+		if !slices.ContainsFunc(s.ChannelsBlacklist, func(c radioAIExcludedChannel) bool {
+			return c.Radio == radioNa && c.Channel == dfs
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// radioAIModel represents the data model for the Radio AI settings of a site.
+type radioAIModel struct {
+	base.Model
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	CronExpr           types.String `tfsdk:"cron_expr"`
+	Radios             types.Set    `tfsdk:"radios"`
+	Optimize           types.Set    `tfsdk:"optimize"`
+	ExcludedChannelsNg types.Set    `tfsdk:"excluded_channels_ng"`
+	ExcludedChannelsNa types.Set    `tfsdk:"excluded_channels_na"`
+	ExcludedChannels6e types.Set    `tfsdk:"excluded_channels_6e"`
+	ChannelWidthsNg    types.Set    `tfsdk:"channel_widths_ng"`
+	ChannelWidthsNa    types.Set    `tfsdk:"channel_widths_na"`
+	ChannelWidths6e    types.Set    `tfsdk:"channel_widths_6e"`
+	DFS                types.Bool   `tfsdk:"dfs"`
+}
+
+func setToInts(ctx context.Context, set types.Set, diags *diag.Diagnostics) []int {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+	var values []int64
+	diags.Append(set.ElementsAs(ctx, &values, false)...)
+	ints := make([]int, 0, len(values))
+	for _, v := range values {
+		ints = append(ints, int(v))
+	}
+	return ints
+}
+
+func intsToSet(ctx context.Context, values []int, diags *diag.Diagnostics) types.Set {
+	set, d := types.SetValueFrom(ctx, types.Int64Type, append([]int{}, values...))
+	diags.Append(d...)
+	return set
+}
+
+func (d *radioAIModel) AsUnifiModel(ctx context.Context) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model := &radioAISetting{
+		ID:                d.ID.ValueString(),
+		Key:               "radio_ai",
+		Enabled:           d.Enabled.ValueBool(),
+		CronExpr:          d.CronExpr.ValueString(),
+		ChannelsBlacklist: []radioAIExcludedChannel{},
+		HtModesNg:         setToInts(ctx, d.ChannelWidthsNg, &diags),
+		HtModesNa:         setToInts(ctx, d.ChannelWidthsNa, &diags),
+		HtModes6e:         setToInts(ctx, d.ChannelWidths6e, &diags),
+		excludeDFS:        !d.DFS.IsUnknown() && !d.DFS.ValueBool(),
+	}
+	// Bands and targets not configured are left as set on the controller.
+	if ut.IsDefined(d.Radios) {
+		diags.Append(d.Radios.ElementsAs(ctx, &model.Radios, false)...)
+	}
+	if ut.IsDefined(d.Optimize) {
+		diags.Append(d.Optimize.ElementsAs(ctx, &model.Optimize, false)...)
+	}
+	for radio, set := range map[string]types.Set{radioNg: d.ExcludedChannelsNg, radioNa: d.ExcludedChannelsNa, radio6e: d.ExcludedChannels6e} {
+		for _, c := range setToInts(ctx, set, &diags) {
+			model.ChannelsBlacklist = append(model.ChannelsBlacklist, radioAIExcludedChannel{Channel: c, ChannelWidth: 20, Radio: radio})
+		}
+	}
+	return model, diags
+}
+
+func (d *radioAIModel) Merge(ctx context.Context, other interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model, ok := other.(*radioAISetting)
+	if !ok {
+		diags.AddError("Cannot merge", "Cannot merge type that is not *radioAISetting")
+		return diags
+	}
+
+	d.ID = types.StringValue(model.ID)
+	d.Enabled = types.BoolValue(model.Enabled)
+	d.CronExpr = types.StringValue(model.CronExpr)
+	radios, ds := types.SetValueFrom(ctx, types.StringType, append([]string{}, model.Radios...))
+	diags.Append(ds...)
+	d.Radios = radios
+	optimize, ds := types.SetValueFrom(ctx, types.StringType, append([]string{}, model.Optimize...))
+	diags.Append(ds...)
+	d.Optimize = optimize
+	d.ExcludedChannelsNg = intsToSet(ctx, model.excludedChannels(radioNg), &diags)
+	d.ExcludedChannelsNa = intsToSet(ctx, model.excludedChannels(radioNa), &diags)
+	d.ExcludedChannels6e = intsToSet(ctx, model.excludedChannels(radio6e), &diags)
+	d.ChannelWidthsNg = intsToSet(ctx, model.HtModesNg, &diags)
+	d.ChannelWidthsNa = intsToSet(ctx, model.HtModesNa, &diags)
+	d.ChannelWidths6e = intsToSet(ctx, model.HtModes6e, &diags)
+	d.DFS = types.BoolValue(!model.dfsExcluded())
+	return diags
+}
+
+var (
+	_ base.ResourceModel               = &radioAIModel{}
+	_ resource.Resource                = &radioAIResource{}
+	_ resource.ResourceWithConfigure   = &radioAIResource{}
+	_ resource.ResourceWithImportState = &radioAIResource{}
+	_ resource.ResourceWithModifyPlan  = &radioAIResource{}
+)
+
+type radioAIResource struct {
+	*base.GenericResource[*radioAIModel]
+}
+
+// ModifyPlan validates the excluded channels against the channels allowed in
+// the country of the site. A country changed in the same plan is not taken
+// into account, as it is not applied yet.
+func (r *radioAIResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.GetClient() == nil {
+		return
+	}
+	var plan radioAIModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	domain, diags := planRegulatoryDomain(ctx, r.GetClient(), &plan)
+	resp.Diagnostics.Append(diags...)
+	if domain == nil {
+		return
+	}
+	resp.Diagnostics.Append(domain.validateChannels(ctx, "excluded_channels_ng", radioNg, plan.ExcludedChannelsNg)...)
+	resp.Diagnostics.Append(domain.validateChannels(ctx, "excluded_channels_na", radioNa, plan.ExcludedChannelsNa)...)
+	resp.Diagnostics.Append(domain.validateChannels(ctx, "excluded_channels_6e", radio6e, plan.ExcludedChannels6e)...)
+	if !plan.DFS.IsUnknown() && !plan.DFS.ValueBool() {
+		for _, c := range setToInts(ctx, plan.ExcludedChannelsNa, &resp.Diagnostics) {
+			if domain.isDFS(c) {
+				resp.Diagnostics.AddAttributeError(path.Root("excluded_channels_na"), "Redundant DFS channel",
+					fmt.Sprintf("Channel %d is a DFS channel, which are all excluded when `dfs` is false.", c))
+			}
+		}
+	}
+}
+
+func (r *radioAIResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	excludedChannels := func(band string) schema.SetAttribute {
+		return schema.SetAttribute{
+			MarkdownDescription: fmt.Sprintf("The %s channels Radio AI does not assign to access points. Channels must be allowed in the "+
+				"country of the site, set with `unifi_setting_country`.", band),
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Computed:    true,
+			Default:     ut.DefaultEmptySet(types.Int64Type),
+		}
+	}
+	channelWidths := func(band string, widths ...int64) schema.SetAttribute {
+		valid := make([]string, 0, len(widths))
+		for _, w := range widths {
+			valid = append(valid, fmt.Sprintf("`%d`", w))
+		}
+		return schema.SetAttribute{
+			MarkdownDescription: fmt.Sprintf("The channel widths in MHz Radio AI may assign to %s radios. Valid values are %s.", band, strings.Join(valid, ", ")),
+			ElementType:         types.Int64Type,
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueInt64sAre(int64validator.OneOf(widths...)),
+			},
+		}
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_setting_radio_ai` resource manages Radio AI, the nightly channel optimization of the access points of a UniFi site.\n\n" +
+			"Excluded channels are validated against the channels allowed in the country of the site when planning.",
+		Attributes: map[string]schema.Attribute{
+			"id":   ut.ID(),
+			"site": ut.SiteAttribute(),
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether Radio AI optimizes the channels of access points.",
+				Required:            true,
+			},
+			"cron_expr": schema.StringAttribute{
+				MarkdownDescription: "The schedule of the optimization as a cron expression of five fields, e.g. `0 3 * * *` for every night at 3 AM.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cronExpression, "must be a cron expression of five fields"),
+				},
+			},
+			"radios": schema.SetAttribute{
+				MarkdownDescription: "The bands to optimize. Valid values are `ng` (2.4 GHz), `na` (5 GHz) and `6e` (6 GHz).",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(radioNg, radioNa, radio6e)),
+				},
+			},
+			"optimize": schema.SetAttribute{
+				MarkdownDescription: "What to optimize. Valid values are `channel` and `power`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("channel", "power")),
+				},
+			},
+			"excluded_channels_ng": excludedChannels("2.4 GHz"),
+			"excluded_channels_na": excludedChannels("5 GHz"),
+			"excluded_channels_6e": excludedChannels("6 GHz"),
+			"channel_widths_ng":    channelWidths("2.4 GHz", 20, 40),
+			"channel_widths_na":    channelWidths("5 GHz", 20, 40, 80, 160),
+			"channel_widths_6e":    channelWidths("6 GHz", 20, 40, 80, 160, 320),
+			"dfs": schema.BoolAttribute{
+				MarkdownDescription: "Whether Radio AI may assign DFS channels of the 5 GHz band. When `false`, all DFS channels of the country " +
+					"of the site are excluded, in addition to `excluded_channels_na`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// NewRadioAIResource creates a new instance of the Radio AI setting resource.
+func NewRadioAIResource() resource.Resource {
+	r := &radioAIResource{}
+	r.GenericResource = NewSettingResource(
+		"unifi_setting_radio_ai",
+		func() *radioAIModel { return &radioAIModel{} },
+		func(ctx context.Context, client *base.Client, site string) (interface{}, error) {
+			setting, err := base.GetSetting[radioAISetting](ctx, client, site, "radio_ai")
+			if err != nil {
+				return nil, err
+			}
+			return setting, withDFSChannels(ctx, client, site, setting)
+		},
+		func(ctx context.Context, client *base.Client, site string, body interface{}) (interface{}, error) {
+			b, _ := body.(*radioAISetting)
+			if err := withDFSChannels(ctx, client, site, b); err != nil {
+				return nil, err
+			}
+			if b.excludeDFS {
+				for _, c := range b.dfsChannels {
+					if !slices.ContainsFunc(b.ChannelsBlacklist, func(e radioAIExcludedChannel) bool { return e.Radio == radioNa && e.Channel == c }) {
+						b.ChannelsBlacklist = append(b.ChannelsBlacklist, radioAIExcludedChannel{Channel: c, ChannelWidth: 20, Radio: radioNa})
+					}
+				}
+			}
+			setting, err := base.UpdateSetting(ctx, client, site, "radio_ai", b)
+			if err != nil {
+				return nil, err
+			}
+			setting.dfsChannels = b.dfsChannels
+			return setting, nil
+		},
+	)
+	return r
+}
+
+// withDFSChannels sets the DFS channels of the country of the site on the
+// setting.
+func withDFSChannels(ctx context.Context, client *base.Client, site string, setting *radioAISetting) error {
+	domain, err := getRegulatoryDomain(ctx, client, site)
+	if err != nil {
+		return fmt.Errorf("failed to read the channels allowed on site %q: %w", site, err)
+	}
+	setting.dfsChannels = domain.ChannelsNaDfs
+	return nil
+}
@@ -0,0 +1,85 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRadioAIModel_AsUnifiModel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	model := radioAIModel{
+		Enabled:            types.BoolValue(true),
+		CronExpr:           types.StringValue("0 3 * * *"),
+		Radios:             types.SetUnknown(types.StringType),
+		ExcludedChannelsNg: types.SetValueMust(types.Int64Type, nil),
+		ExcludedChannels6e: types.SetValueMust(types.Int64Type, nil),
+		ChannelWidthsNg:    types.SetUnknown(types.Int64Type),
+		ChannelWidths6e:    types.SetNull(types.Int64Type),
+		DFS:                types.BoolValue(false),
+	}
+	model.ExcludedChannelsNa, _ = types.SetValueFrom(ctx, types.Int64Type, []int64{36})
+	model.Optimize, _ = types.SetValueFrom(ctx, types.StringType, []string{"channel"})
+	model.ChannelWidthsNa, _ = types.SetValueFrom(ctx, types.Int64Type, []int64{40, 80})
+
+	unifiModel, diags := model.AsUnifiModel(ctx)
+	require.False(t, diags.HasError())
+	setting, ok := unifiModel.(*radioAISetting)
+	require.True(t, ok)
+
+	assert.Equal(t, "radio_ai", setting.Key)
+	assert.True(t, setting.Enabled)
+	assert.Equal(t, "0 3 * * *", setting.CronExpr)
+	// Unknown bands are left as set on the controller.
+	assert.Nil(t, setting.Radios)
+	assert.Equal(t, []string{"channel"}, setting.Optimize)
+	assert.Equal(t, []radioAIExcludedChannel{{Channel: 36, ChannelWidth: 20, Radio: radioNa}}, setting.ChannelsBlacklist)
+	assert.Nil(t, setting.HtModesNg)
+	assert.ElementsMatch(t, []int{40, 80}, setting.HtModesNa)
+	assert.True(t, setting.excludeDFS)
+}
+
+func TestRadioAIModel_Merge(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	setting := &radioAISetting{
+		ID:       "test-id",
+		Enabled:  true,
+		CronExpr: "0 3 * * *",
+		Radios:   []string{radioNg, radioNa},
+		Optimize: []string{"channel", "power"},
+		ChannelsBlacklist: []radioAIExcludedChannel{
+			{Channel: 13, ChannelWidth: 20, Radio: radioNg},
+			{Channel: 36, ChannelWidth: 20, Radio: radioNa},
+			{Channel: 52, ChannelWidth: 20, Radio: radioNa},
+			{Channel: 56, ChannelWidth: 20, Radio: radioNa},
+		},
+		HtModesNa:   []int{20, 40},
+		dfsChannels: []int{52, 56},
+	}
+
+	var model radioAIModel
+	require.False(t, model.Merge(ctx, setting).HasError())
+	assert.Equal(t, "test-id", model.ID.ValueString())
+	assert.Equal(t, "0 3 * * *", model.CronExpr.ValueString())
+	assert.Len(t, model.Radios.Elements(), 2)
+	// All DFS channels are excluded, so they are represented by dfs only.
+	assert.False(t, model.DFS.ValueBool())
+	assert.Equal(t, types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(36)}), model.ExcludedChannelsNa)
+	assert.Equal(t, types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(13)}), model.ExcludedChannelsNg)
+	assert.Empty(t, model.ExcludedChannels6e.Elements())
+	assert.Empty(t, model.ChannelWidthsNg.Elements())
+
+	// With a DFS channel allowed, the excluded DFS channels are kept.
+	setting.ChannelsBlacklist = setting.ChannelsBlacklist[:3]
+	require.False(t, model.Merge(ctx, setting).HasError())
+	assert.True(t, model.DFS.ValueBool())
+	assert.Len(t, model.ExcludedChannelsNa.Elements(), 2)
+}
@@ -10,6 +10,7 @@
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
 )
 
 func ResourceSettingRadius() *schema.Resource {
@@ -40,11 +41,12 @@ func ResourceSettingRadius() *schema.Resource {
 				Computed:    true,
 			},
 			"site": {
-				Description: "The name of the UniFi site where these RADIUS settings should be applied. If not specified, the default site will be used.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				ForceNew:    true,
+				Description:      "The name of the UniFi site where these RADIUS settings should be applied. If not specified, the default site will be used.",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: utils.SiteDiffSuppressFunc,
 			},
 			"accounting_enabled": {
 				Description: "Enable RADIUS accounting to track user sessions, including connection time, data usage, and other metrics. " +
@@ -130,9 +132,9 @@ func resourceSettingRadiusCreate(ctx context.Context, d *schema.ResourceData, me
 
 	req := resourceSettingRadiusGetResourceData(d)
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.UpdateSettingRadius(ctx, site, req)
@@ -179,9 +181,9 @@ func resourceSettingRadiusRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("unexpected meta type: %T", meta)
 	}
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.GetSettingRadius(ctx, site)
@@ -205,9 +207,9 @@ func resourceSettingRadiusUpdate(ctx context.Context, d *schema.ResourceData, me
 	req := resourceSettingRadiusGetResourceData(d)
 
 	req.ID = d.Id()
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.UpdateSettingRadius(ctx, site, req)
@@ -0,0 +1,168 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/validators"
+)
+
+// rawSettingReservedFields are the fields of a setting identifying it, which
+// are managed by the provider rather than the `value` of the setting.
+var rawSettingReservedFields = []string{"_id", "key", "site_id"}
+
+var settingKeyRegex = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// rawSetting is a setting of a site with any key, as returned by the
+// controller.
+type rawSetting map[string]interface{}
+
+// rawSettingModel represents the data model for a setting of a site not
+// modeled by a dedicated resource. The ID of the resource is the key of the
+// setting, so it can be read without knowing the ID the controller assigned.
+type rawSettingModel struct {
+	base.Model
+	Key   types.String `tfsdk:"key"`
+	Value ut.JSONValue `tfsdk:"value"`
+}
+
+func (d *rawSettingModel) AsUnifiModel(_ context.Context) (interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	model := rawSetting{}
+	if err := json.Unmarshal([]byte(d.Value.ValueString()), &model); err != nil {
+		diags.AddAttributeError(path.Root("value"), "Invalid setting value", err.Error())
+		return nil, diags
+	}
+	model["key"] = d.Key.ValueString()
+	return &model, diags
+}
+
+// Merge sets the value to the fields of the setting managed so far, i.e. the
+// fields of the current value. Without a current value, as when importing, all
+// fields of the setting are managed.
+func (d *rawSettingModel) Merge(_ context.Context, other interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model, ok := other.(*rawSetting)
+	if !ok {
+		diags.AddError("Cannot merge", "Cannot merge type that is not *rawSetting")
+		return diags
+	}
+
+	key, _ := (*model)["key"].(string)
+	d.ID = types.StringValue(key)
+	d.Key = types.StringValue(key)
+
+	var managed map[string]interface{}
+	if ut.IsDefined(d.Value) {
+		if err := json.Unmarshal([]byte(d.Value.ValueString()), &managed); err != nil {
+			diags.AddAttributeError(path.Root("value"), "Invalid setting value", err.Error())
+			return diags
+		}
+	}
+	value := map[string]interface{}{}
+	for field, v := range *model {
+		if _, ok := managed[field]; (managed == nil || ok) && !slices.Contains(rawSettingReservedFields, field) {
+			value[field] = v
+		}
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		diags.AddError("Cannot encode setting value", err.Error())
+		return diags
+	}
+	d.Value = ut.NewJSONValue(string(b))
+	return diags
+}
+
+var (
+	_ base.ResourceModel               = &rawSettingModel{}
+	_ resource.Resource                = &rawSettingResource{}
+	_ resource.ResourceWithConfigure   = &rawSettingResource{}
+	_ resource.ResourceWithImportState = &rawSettingResource{}
+)
+
+type rawSettingResource struct {
+	*base.GenericResource[*rawSettingModel]
+}
+
+// Update manages the fields of the planned value. The generic update merges
+// the setting into the prior state, which holds the previously managed fields,
+// so the planned value is set on the prior state first.
+func (r *rawSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var value ut.JSONValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("value"), &value)...)
+	resp.Diagnostics.Append(req.State.SetAttribute(ctx, path.Root("value"), value)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.GenericResource.Update(ctx, req, resp)
+}
+
+func (r *rawSettingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_setting` resource manages any setting of a UniFi site by its key, for settings not modeled by a dedicated `unifi_setting_*` resource yet.\n\n" +
+			"Only the fields given in `value` are managed: other fields of the setting are left as set on the controller, and fields removed from `value` are no longer managed. " +
+			"When imported, all fields of the setting are managed. Prefer the dedicated resource of a setting where one exists, as it validates the setting.",
+		Attributes: map[string]schema.Attribute{
+			"id":   ut.ID("The key of the setting."),
+			"site": ut.SiteAttribute(),
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the setting, e.g. `ntp` or `radio_ai`. Changing it replaces the resource.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(settingKeyRegex, "must contain lowercase letters, digits and underscores only"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The fields of the setting to manage as a JSON-encoded object, e.g. using `jsonencode()`. " +
+					"The value read from the controller is compared as a JSON document, so its formatting and key order do not produce a diff. " +
+					"The `_id`, `key` and `site_id` fields are managed by the provider and cannot be set.",
+				CustomType: ut.JSONType{},
+				Required:   true,
+				Validators: []validator.String{
+					validators.JSONObject(rawSettingReservedFields...),
+				},
+			},
+		},
+	}
+}
+
+// NewRawSettingResource creates a new instance of the resource managing any
+// setting by its key.
+func NewRawSettingResource() resource.Resource {
+	r := &rawSettingResource{}
+	r.GenericResource = NewSettingResource(
+		"unifi_setting",
+		func() *rawSettingModel { return &rawSettingModel{} },
+		nil,
+		func(ctx context.Context, client *base.Client, site string, body interface{}) (interface{}, error) {
+			b, _ := body.(*rawSetting)
+			key, _ := (*b)["key"].(string)
+			return base.UpdateSetting(ctx, client, site, key, b)
+		},
+	)
+	// The key of the setting to read is the ID of the resource.
+	r.Handlers.Read = func(ctx context.Context, client *base.Client, site, key string) (interface{}, error) {
+		return base.GetSetting[rawSetting](ctx, client, site, key)
+	}
+	return r
+}
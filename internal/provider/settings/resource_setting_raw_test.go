@@ -0,0 +1,57 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+func TestRawSettingModel_AsUnifiModel(t *testing.T) {
+	t.Parallel()
+
+	model := rawSettingModel{
+		Key:   types.StringValue("ntp"),
+		Value: ut.NewJSONValue(`{"ntp_server_1": "pool.ntp.org", "setting_preference": "manual"}`),
+	}
+
+	unifiModel, diags := model.AsUnifiModel(context.Background())
+	require.False(t, diags.HasError())
+	setting, ok := unifiModel.(*rawSetting)
+	require.True(t, ok)
+
+	assert.Equal(t, rawSetting{
+		"key":                "ntp",
+		"ntp_server_1":       "pool.ntp.org",
+		"setting_preference": "manual",
+	}, *setting)
+}
+
+func TestRawSettingModel_Merge(t *testing.T) {
+	t.Parallel()
+
+	setting := &rawSetting{
+		"_id":                "test-id",
+		"key":                "ntp",
+		"site_id":            "site-id",
+		"ntp_server_1":       "pool.ntp.org",
+		"ntp_server_2":       "time.google.com",
+		"setting_preference": "manual",
+	}
+
+	// Only the fields of the current value are managed.
+	model := rawSettingModel{Value: ut.NewJSONValue(`{"setting_preference": "auto", "ntp_server_1": ""}`)}
+	require.False(t, model.Merge(context.Background(), setting).HasError())
+	assert.Equal(t, "ntp", model.ID.ValueString())
+	assert.Equal(t, "ntp", model.Key.ValueString())
+	assert.JSONEq(t, `{"ntp_server_1": "pool.ntp.org", "setting_preference": "manual"}`, model.Value.ValueString())
+
+	// Without a current value, all fields but the reserved ones are managed.
+	model = rawSettingModel{Value: ut.JSONValue{StringValue: types.StringNull()}}
+	require.False(t, model.Merge(context.Background(), setting).HasError())
+	assert.JSONEq(t, `{"ntp_server_1": "pool.ntp.org", "ntp_server_2": "time.google.com", "setting_preference": "manual"}`, model.Value.ValueString())
+}
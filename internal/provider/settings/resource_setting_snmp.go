@@ -0,0 +1,200 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/validators"
+)
+
+// snmpSetting is the `snmp` setting of a site, which configures the SNMP agent
+// of the devices of the site.
+type snmpSetting struct {
+	ID               string `json:"_id,omitempty"`
+	Key              string `json:"key"`
+	Enabled          bool   `json:"enabled"`
+	Community        string `json:"community,omitempty"`
+	EnabledV3        bool   `json:"enabledV3"`
+	Username         string `json:"username,omitempty"`
+	XPassword        string `json:"x_password,omitempty"`
+	XPrivacyPassword string `json:"x_privacy_password,omitempty"`
+}
+
+// snmpModel represents the data model for the SNMP settings of a site. The
+// passphrases are write-only, so they are never stored in the state.
+type snmpModel struct {
+	base.Model
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Community          types.String `tfsdk:"community"`
+	V3Enabled          types.Bool   `tfsdk:"v3_enabled"`
+	Username           types.String `tfsdk:"username"`
+	AuthPassphrase     types.String `tfsdk:"auth_passphrase"`
+	PrivacyPassphrase  types.String `tfsdk:"privacy_passphrase"`
+	PassphrasesVersion types.Int64  `tfsdk:"passphrases_version"`
+}
+
+func (d *snmpModel) LoadWriteOnly(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	diags := config.GetAttribute(ctx, path.Root("auth_passphrase"), &d.AuthPassphrase)
+	diags.Append(config.GetAttribute(ctx, path.Root("privacy_passphrase"), &d.PrivacyPassphrase)...)
+	return diags
+}
+
+func (d *snmpModel) GetWriteOnlyVersion() types.Int64 {
+	return d.PassphrasesVersion
+}
+
+func (d *snmpModel) SetWriteOnlyVersion(version types.Int64) {
+	d.PassphrasesVersion = version
+}
+
+func (d *snmpModel) AsUnifiModel(_ context.Context) (interface{}, diag.Diagnostics) {
+	return &snmpSetting{
+		ID:               d.ID.ValueString(),
+		Key:              "snmp",
+		Enabled:          d.Enabled.ValueBool(),
+		Community:        d.Community.ValueString(),
+		EnabledV3:        d.V3Enabled.ValueBool(),
+		Username:         d.Username.ValueString(),
+		XPassword:        d.AuthPassphrase.ValueString(),
+		XPrivacyPassword: d.PrivacyPassphrase.ValueString(),
+	}, nil
+}
+
+func (d *snmpModel) Merge(_ context.Context, other interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model, ok := other.(*snmpSetting)
+	if !ok {
+		diags.AddError("Cannot merge", "Cannot merge type that is not *snmpSetting")
+		return diags
+	}
+
+	d.ID = types.StringValue(model.ID)
+	d.Enabled = types.BoolValue(model.Enabled)
+	d.Community = ut.StringOrNull(model.Community)
+	d.V3Enabled = types.BoolValue(model.EnabledV3)
+	d.Username = ut.StringOrNull(model.Username)
+	// Write-only attributes must be null in the state.
+	d.AuthPassphrase = types.StringNull()
+	d.PrivacyPassphrase = types.StringNull()
+	return diags
+}
+
+var (
+	_ base.ResourceModel                    = &snmpModel{}
+	_ base.WriteOnlyVersionModel            = &snmpModel{}
+	_ resource.Resource                     = &snmpResource{}
+	_ resource.ResourceWithConfigure        = &snmpResource{}
+	_ resource.ResourceWithImportState      = &snmpResource{}
+	_ resource.ResourceWithConfigValidators = &snmpResource{}
+	_ resource.ResourceWithModifyPlan       = &snmpResource{}
+)
+
+type snmpResource struct {
+	*base.GenericResource[*snmpModel]
+}
+
+func (r *snmpResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.RequiredSimpleTogetherIf("v3_enabled", types.BoolValue(true), "username", "auth_passphrase"),
+	}
+}
+
+// ModifyPlan gates the privacy passphrase of SNMPv3, which older controllers
+// do not support; they encrypt SNMPv3 traffic with the authentication
+// passphrase.
+func (r *snmpResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(r.RequireMinVersionForPath("8.1", path.Root("privacy_passphrase"), req.Config)...)
+}
+
+func (r *snmpResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	passphraseValidators := func() []validator.String {
+		return []validator.String{
+			stringvalidator.LengthAtLeast(8),
+		}
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_setting_snmp` resource manages the SNMP agent of the devices of a UniFi site, so switches and access points " +
+			"can be polled by monitoring systems.\n\n" +
+			"The SNMPv3 passphrases are write-only: they are sent to the controller but never stored in the Terraform state. " +
+			"Terraform does not detect changes of write-only attributes, so change `passphrases_version` to update the passphrases. " +
+			"Write-only attributes require Terraform 1.11 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id":   ut.ID(),
+			"site": ut.SiteAttribute(),
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether devices answer SNMPv1 and SNMPv2c requests with the `community`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"community": schema.StringAttribute{
+				MarkdownDescription: "The SNMPv1 and SNMPv2c community string.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"v3_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether devices answer SNMPv3 requests of the user `username`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The SNMPv3 user. Required when `v3_enabled` is `true`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"auth_passphrase": schema.StringAttribute{
+				MarkdownDescription: "The SNMPv3 authentication passphrase of at least 8 characters. Required when `v3_enabled` is `true`.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators:          passphraseValidators(),
+			},
+			"privacy_passphrase": schema.StringAttribute{
+				MarkdownDescription: "The SNMPv3 privacy passphrase of at least 8 characters, encrypting SNMPv3 traffic. Requires controller version 8.1 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators:          passphraseValidators(),
+			},
+			"passphrases_version": schema.Int64Attribute{
+				MarkdownDescription: "An arbitrary version of the passphrases. Change it to send changed `auth_passphrase` and `privacy_passphrase` to the controller.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// NewSnmpResource creates a new instance of the SNMP setting resource.
+func NewSnmpResource() resource.Resource {
+	r := &snmpResource{}
+	r.GenericResource = NewSettingResource(
+		"unifi_setting_snmp",
+		func() *snmpModel { return &snmpModel{} },
+		func(ctx context.Context, client *base.Client, site string) (interface{}, error) {
+			return base.GetSetting[snmpSetting](ctx, client, site, "snmp")
+		},
+		func(ctx context.Context, client *base.Client, site string, body interface{}) (interface{}, error) {
+			b, _ := body.(*snmpSetting)
+			return base.UpdateSetting(ctx, client, site, "snmp", b)
+		},
+	)
+	return r
+}
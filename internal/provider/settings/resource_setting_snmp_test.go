@@ -0,0 +1,63 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnmpModel_AsUnifiModel(t *testing.T) {
+	t.Parallel()
+
+	model := snmpModel{
+		Enabled:           types.BoolValue(true),
+		Community:         types.StringValue("public"),
+		V3Enabled:         types.BoolValue(true),
+		Username:          types.StringValue("monitoring"),
+		AuthPassphrase:    types.StringValue("auth-secret"),
+		PrivacyPassphrase: types.StringNull(),
+	}
+	model.ID = types.StringValue("test-id")
+
+	unifiModel, diags := model.AsUnifiModel(context.Background())
+	require.False(t, diags.HasError())
+	snmp, ok := unifiModel.(*snmpSetting)
+	require.True(t, ok)
+
+	assert.Equal(t, "test-id", snmp.ID)
+	assert.Equal(t, "snmp", snmp.Key)
+	assert.True(t, snmp.Enabled)
+	assert.Equal(t, "public", snmp.Community)
+	assert.True(t, snmp.EnabledV3)
+	assert.Equal(t, "monitoring", snmp.Username)
+	assert.Equal(t, "auth-secret", snmp.XPassword)
+	assert.Empty(t, snmp.XPrivacyPassword)
+}
+
+func TestSnmpModel_Merge(t *testing.T) {
+	t.Parallel()
+
+	model := snmpModel{
+		AuthPassphrase:    types.StringValue("auth-secret"),
+		PrivacyPassphrase: types.StringValue("privacy-secret"),
+	}
+	diags := model.Merge(context.Background(), &snmpSetting{
+		ID:        "test-id",
+		Enabled:   true,
+		EnabledV3: true,
+		Username:  "monitoring",
+		XPassword: "auth-secret",
+	})
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "test-id", model.ID.ValueString())
+	assert.True(t, model.Enabled.ValueBool())
+	assert.True(t, model.Community.IsNull())
+	assert.Equal(t, "monitoring", model.Username.ValueString())
+	// Write-only passphrases are never kept in the state.
+	assert.True(t, model.AuthPassphrase.IsNull())
+	assert.True(t, model.PrivacyPassphrase.IsNull())
+}
@@ -0,0 +1,96 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+// superCloudAccess is the controller-wide `super_cloudaccess` setting, which
+// controls remote access to the controller through the UniFi cloud.
+type superCloudAccess struct {
+	ID       string `json:"_id,omitempty"`
+	Key      string `json:"key"`
+	Enabled  bool   `json:"enabled"`
+	DeviceID string `json:"device_id,omitempty"`
+}
+
+// superCloudAccessModel represents the data model for the controller's cloud
+// access.
+type superCloudAccessModel struct {
+	superSettingModel
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	DeviceID types.String `tfsdk:"device_id"`
+}
+
+func (d *superCloudAccessModel) AsUnifiModel(_ context.Context) (interface{}, diag.Diagnostics) {
+	return &superCloudAccess{
+		ID:       d.ID.ValueString(),
+		Key:      "super_cloudaccess",
+		Enabled:  d.Enabled.ValueBool(),
+		DeviceID: d.DeviceID.ValueString(),
+	}, nil
+}
+
+func (d *superCloudAccessModel) Merge(_ context.Context, other interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model, ok := other.(*superCloudAccess)
+	if !ok {
+		diags.AddError("Cannot merge", "Cannot merge type that is not *superCloudAccess")
+		return diags
+	}
+
+	d.ID = types.StringValue(model.ID)
+	d.Enabled = types.BoolValue(model.Enabled)
+	d.DeviceID = ut.StringOrNull(model.DeviceID)
+	return diags
+}
+
+var (
+	_ base.ResourceModel               = &superCloudAccessModel{}
+	_ resource.Resource                = &superCloudAccessResource{}
+	_ resource.ResourceWithConfigure   = &superCloudAccessResource{}
+	_ resource.ResourceWithImportState = &superCloudAccessResource{}
+)
+
+type superCloudAccessResource struct {
+	*superSettingResource[*superCloudAccessModel]
+}
+
+func (r *superCloudAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_setting_super_cloud_access` resource manages remote access to the UniFi controller through the UniFi cloud.\n\n" +
+			"Disabling cloud access restricts management of the controller to the local network. " +
+			"This is a controller-wide setting shared by all sites.",
+		Attributes: map[string]schema.Attribute{
+			"id": ut.ID(),
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the controller can be accessed remotely through the UniFi cloud.",
+				Required:            true,
+			},
+			"device_id": schema.StringAttribute{
+				MarkdownDescription: "The ID the controller is registered with in the UniFi cloud.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// NewSuperCloudAccessResource creates a new instance of the controller cloud
+// access setting resource.
+func NewSuperCloudAccessResource() resource.Resource {
+	r := &superCloudAccessResource{}
+	r.superSettingResource = newSuperSettingResource[*superCloudAccessModel, superCloudAccess](
+		"unifi_setting_super_cloud_access",
+		func() *superCloudAccessModel { return &superCloudAccessModel{} },
+		"super_cloudaccess",
+	)
+	return r
+}
@@ -0,0 +1,144 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+var updateChannels = []string{"release", "release-candidate", "beta", "alpha", "internal"}
+
+// superFwupdate is the controller-wide `super_fwupdate` setting, which controls
+// the release channels and automatic updates of the controller.
+type superFwupdate struct {
+	ID                string `json:"_id,omitempty"`
+	Key               string `json:"key"`
+	ControllerChannel string `json:"controller_channel,omitempty"`
+	FirmwareChannel   string `json:"firmware_channel,omitempty"`
+	// The auto-update window is only sent when configured, as controllers
+	// without scheduled updates do not know it.
+	AutoUpdate     *bool `json:"auto_update_enabled,omitempty"`
+	AutoUpdateHour *int  `json:"auto_update_hour,omitempty"`
+}
+
+// superFwupdateModel represents the data model for the controller's update
+// settings.
+type superFwupdateModel struct {
+	superSettingModel
+	ControllerChannel types.String `tfsdk:"controller_channel"`
+	FirmwareChannel   types.String `tfsdk:"firmware_channel"`
+	AutoUpdate        types.Bool   `tfsdk:"auto_update"`
+	AutoUpdateHour    types.Int32  `tfsdk:"auto_update_hour"`
+}
+
+func (d *superFwupdateModel) AsUnifiModel(_ context.Context) (interface{}, diag.Diagnostics) {
+	model := &superFwupdate{
+		ID:                d.ID.ValueString(),
+		Key:               "super_fwupdate",
+		ControllerChannel: d.ControllerChannel.ValueString(),
+		FirmwareChannel:   d.FirmwareChannel.ValueString(),
+	}
+	if !d.AutoUpdate.IsNull() && !d.AutoUpdate.IsUnknown() {
+		model.AutoUpdate = d.AutoUpdate.ValueBoolPointer()
+	}
+	if !d.AutoUpdateHour.IsNull() && !d.AutoUpdateHour.IsUnknown() {
+		hour := int(d.AutoUpdateHour.ValueInt32())
+		model.AutoUpdateHour = &hour
+	}
+	return model, nil
+}
+
+func (d *superFwupdateModel) Merge(_ context.Context, other interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model, ok := other.(*superFwupdate)
+	if !ok {
+		diags.AddError("Cannot merge", "Cannot merge type that is not *superFwupdate")
+		return diags
+	}
+
+	d.ID = types.StringValue(model.ID)
+	d.ControllerChannel = types.StringValue(model.ControllerChannel)
+	d.FirmwareChannel = types.StringValue(model.FirmwareChannel)
+	d.AutoUpdate = types.BoolPointerValue(model.AutoUpdate)
+	d.AutoUpdateHour = types.Int32Null()
+	if model.AutoUpdateHour != nil {
+		d.AutoUpdateHour = types.Int32Value(int32(*model.AutoUpdateHour))
+	}
+	return diags
+}
+
+var (
+	_ base.ResourceModel               = &superFwupdateModel{}
+	_ resource.Resource                = &superFwupdateResource{}
+	_ resource.ResourceWithConfigure   = &superFwupdateResource{}
+	_ resource.ResourceWithImportState = &superFwupdateResource{}
+)
+
+type superFwupdateResource struct {
+	*superSettingResource[*superFwupdateModel]
+}
+
+func (r *superFwupdateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_setting_super_fwupdate` resource manages the release channels the UniFi controller and device firmware " +
+			"are updated from, and the window the controller updates itself in.\n\n" +
+			"This is a controller-wide setting shared by all sites. The automatic update window of device firmware is set per site " +
+			"with `unifi_setting_mgmt`.",
+		Attributes: map[string]schema.Attribute{
+			"id": ut.ID(),
+			"controller_channel": schema.StringAttribute{
+				MarkdownDescription: "The release channel of controller updates. Valid values are `release`, `release-candidate`, `beta`, `alpha` and `internal`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(updateChannels...),
+				},
+			},
+			"firmware_channel": schema.StringAttribute{
+				MarkdownDescription: "The release channel of device firmware updates. Valid values are `release`, `release-candidate`, `beta`, `alpha` and `internal`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(updateChannels...),
+				},
+			},
+			"auto_update": schema.BoolAttribute{
+				MarkdownDescription: "Whether the controller updates itself automatically within the window starting at `auto_update_hour`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"auto_update_hour": schema.Int32Attribute{
+				MarkdownDescription: "The hour (0-23) of the day the automatic update window of the controller starts at.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(0, 23),
+					int32validator.AlsoRequires(path.MatchRoot("auto_update")),
+				},
+			},
+		},
+	}
+}
+
+// NewSuperFwupdateResource creates a new instance of the controller update
+// setting resource.
+func NewSuperFwupdateResource() resource.Resource {
+	r := &superFwupdateResource{}
+	r.superSettingResource = newSuperSettingResource[*superFwupdateModel, superFwupdate](
+		"unifi_setting_super_fwupdate",
+		func() *superFwupdateModel { return &superFwupdateModel{} },
+		"super_fwupdate",
+	)
+	return r
+}
@@ -0,0 +1,106 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/validators"
+)
+
+// superIdentity is the controller-wide `super_identity` setting, the name and
+// hostname the controller is known by.
+type superIdentity struct {
+	ID       string `json:"_id,omitempty"`
+	Key      string `json:"key"`
+	Hostname string `json:"hostname,omitempty"`
+	Name     string `json:"name,omitempty"`
+}
+
+// superIdentityModel represents the data model for the controller's identity.
+type superIdentityModel struct {
+	superSettingModel
+	Hostname types.String `tfsdk:"hostname"`
+	Name     types.String `tfsdk:"name"`
+}
+
+func (d *superIdentityModel) AsUnifiModel(_ context.Context) (interface{}, diag.Diagnostics) {
+	return &superIdentity{
+		ID:       d.ID.ValueString(),
+		Key:      "super_identity",
+		Hostname: d.Hostname.ValueString(),
+		Name:     d.Name.ValueString(),
+	}, nil
+}
+
+func (d *superIdentityModel) Merge(_ context.Context, other interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model, ok := other.(*superIdentity)
+	if !ok {
+		diags.AddError("Cannot merge", "Cannot merge type that is not *superIdentity")
+		return diags
+	}
+
+	d.ID = types.StringValue(model.ID)
+	d.Hostname = types.StringValue(model.Hostname)
+	d.Name = types.StringValue(model.Name)
+	return diags
+}
+
+var (
+	_ base.ResourceModel               = &superIdentityModel{}
+	_ resource.Resource                = &superIdentityResource{}
+	_ resource.ResourceWithConfigure   = &superIdentityResource{}
+	_ resource.ResourceWithImportState = &superIdentityResource{}
+)
+
+type superIdentityResource struct {
+	*superSettingResource[*superIdentityModel]
+}
+
+func (r *superIdentityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_setting_super_identity` resource manages the name and hostname of the UniFi controller.\n\n" +
+			"The hostname is the address devices use to reach the controller, e.g. to inform it after being adopted. " +
+			"This is a controller-wide setting shared by all sites.",
+		Attributes: map[string]schema.Attribute{
+			"id": ut.ID(),
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname or IP address devices reach the controller at.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.Any(validators.Hostname(), validators.IPv4()),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the controller.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// NewSuperIdentityResource creates a new instance of the controller identity
+// setting resource.
+func NewSuperIdentityResource() resource.Resource {
+	r := &superIdentityResource{}
+	r.superSettingResource = newSuperSettingResource[*superIdentityModel, superIdentity](
+		"unifi_setting_super_identity",
+		func() *superIdentityModel { return &superIdentityModel{} },
+		"super_identity",
+	)
+	return r
+}
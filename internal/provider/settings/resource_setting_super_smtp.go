@@ -0,0 +1,199 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+// superSmtp is the controller-wide `super_smtp` setting, the mail server the
+// controller sends alerts and invitations through.
+type superSmtp struct {
+	ID        string `json:"_id,omitempty"`
+	Key       string `json:"key"`
+	Enabled   bool   `json:"enabled"`
+	Host      string `json:"host,omitempty"`
+	Port      int    `json:"port,omitempty"`
+	UseSSL    bool   `json:"use_ssl"`
+	UseAuth   bool   `json:"use_auth"`
+	Username  string `json:"username,omitempty"`
+	XPassword string `json:"x_password,omitempty"`
+	UseSender bool   `json:"use_sender"`
+	Sender    string `json:"sender,omitempty"`
+}
+
+// superSmtpModel represents the data model for the controller's SMTP settings.
+// The password is write-only, so it is never stored in the state.
+type superSmtpModel struct {
+	superSettingModel
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Host            types.String `tfsdk:"host"`
+	Port            types.Int32  `tfsdk:"port"`
+	UseSSL          types.Bool   `tfsdk:"use_ssl"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+	Sender          types.String `tfsdk:"sender"`
+}
+
+func (d *superSmtpModel) LoadWriteOnly(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	return config.GetAttribute(ctx, path.Root("password"), &d.Password)
+}
+
+func (d *superSmtpModel) GetWriteOnlyVersion() types.Int64 {
+	return d.PasswordVersion
+}
+
+func (d *superSmtpModel) SetWriteOnlyVersion(version types.Int64) {
+	d.PasswordVersion = version
+}
+
+func (d *superSmtpModel) AsUnifiModel(_ context.Context) (interface{}, diag.Diagnostics) {
+	return &superSmtp{
+		ID:        d.ID.ValueString(),
+		Key:       "super_smtp",
+		Enabled:   d.Enabled.ValueBool(),
+		Host:      d.Host.ValueString(),
+		Port:      int(d.Port.ValueInt32()),
+		UseSSL:    d.UseSSL.ValueBool(),
+		UseAuth:   !ut.IsEmptyString(d.Username),
+		Username:  d.Username.ValueString(),
+		XPassword: d.Password.ValueString(),
+		UseSender: !ut.IsEmptyString(d.Sender),
+		Sender:    d.Sender.ValueString(),
+	}, nil
+}
+
+func (d *superSmtpModel) Merge(_ context.Context, other interface{}) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	model, ok := other.(*superSmtp)
+	if !ok {
+		diags.AddError("Cannot merge", "Cannot merge type that is not *superSmtp")
+		return diags
+	}
+
+	d.ID = types.StringValue(model.ID)
+	d.Enabled = types.BoolValue(model.Enabled)
+	d.Host = types.StringValue(model.Host)
+	d.Port = types.Int32Value(int32(model.Port))
+	d.UseSSL = types.BoolValue(model.UseSSL)
+	d.Username = types.StringNull()
+	if model.UseAuth {
+		d.Username = ut.StringOrNull(model.Username)
+	}
+	// Write-only attributes must be null in the state.
+	d.Password = types.StringNull()
+	d.Sender = types.StringNull()
+	if model.UseSender {
+		d.Sender = ut.StringOrNull(model.Sender)
+	}
+	return diags
+}
+
+var (
+	_ base.ResourceModel               = &superSmtpModel{}
+	_ base.WriteOnlyVersionModel       = &superSmtpModel{}
+	_ resource.Resource                = &superSmtpResource{}
+	_ resource.ResourceWithConfigure   = &superSmtpResource{}
+	_ resource.ResourceWithImportState = &superSmtpResource{}
+)
+
+type superSmtpResource struct {
+	*superSettingResource[*superSmtpModel]
+}
+
+func (r *superSmtpResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_setting_super_smtp` resource manages the mail server the UniFi controller sends e-mails through, " +
+			"like alerts and administrator invitations.\n\n" +
+			"This is a controller-wide setting shared by all sites.\n\n" +
+			"The password is write-only: it is sent to the controller but never stored in the Terraform state. " +
+			"Terraform does not detect changes of write-only attributes, so change `password_version` to update the password. " +
+			"Write-only attributes require Terraform 1.11 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id": ut.ID(),
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the controller sends e-mails through the mail server.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The hostname or IP address of the mail server.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "The port of the mail server.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(25),
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"use_ssl": schema.BoolAttribute{
+				MarkdownDescription: "Whether to connect to the mail server with SSL/TLS.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username to authenticate to the mail server with. Authentication is disabled when not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password to authenticate to the mail server with.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("username")),
+				},
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "An arbitrary version of the password. Change it to send a changed `password` to the controller.",
+				Optional:            true,
+			},
+			"sender": schema.StringAttribute{
+				MarkdownDescription: "The sender address of e-mails. The controller's default sender is used when not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// NewSuperSmtpResource creates a new instance of the controller SMTP setting
+// resource.
+func NewSuperSmtpResource() resource.Resource {
+	r := &superSmtpResource{}
+	r.superSettingResource = newSuperSettingResource[*superSmtpModel, superSmtp](
+		"unifi_setting_super_smtp",
+		func() *superSmtpModel { return &superSmtpModel{} },
+		"super_smtp",
+	)
+	return r
+}
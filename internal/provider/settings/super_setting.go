@@ -0,0 +1,75 @@
+package settings
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+)
+
+// superSite is the site controller-wide settings, whose keys start with
+// `super_`, are stored in.
+const superSite = "default"
+
+// superSettingModel is embedded by models of controller-wide settings. These
+// settings have no `site` attribute, as they always belong to the default site.
+type superSettingModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (m *superSettingModel) GetID() string {
+	return m.ID.ValueString()
+}
+
+func (m *superSettingModel) GetRawID() types.String {
+	return m.ID
+}
+
+func (m *superSettingModel) SetID(id string) {
+	m.ID = types.StringValue(id)
+}
+
+func (m *superSettingModel) GetSite() string {
+	return superSite
+}
+
+func (m *superSettingModel) GetRawSite() types.String {
+	return types.StringValue(superSite)
+}
+
+func (m *superSettingModel) SetSite(_ string) {}
+
+// superSettingResource is a resource of a controller-wide setting. It is
+// imported by the ID of the setting alone, as it has no site.
+type superSettingResource[T base.ResourceModel] struct {
+	*base.GenericResource[T]
+}
+
+func (r *superSettingResource[T]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !strings.Contains(req.ID, ":") {
+		req.ID = superSite + ":" + req.ID
+	}
+	r.GenericResource.ImportState(ctx, req, resp)
+}
+
+// newSuperSettingResource creates a new resource of a controller-wide setting
+// with the given key, which is read and written through the setting endpoints
+// of the default site.
+func newSuperSettingResource[T base.ResourceModel, S any](typeName string, modelFactory func() T, key string) *superSettingResource[T] {
+	return &superSettingResource[T]{
+		GenericResource: NewSettingResource(
+			typeName,
+			modelFactory,
+			func(ctx context.Context, client *base.Client, _ string) (interface{}, error) {
+				return base.GetSetting[S](ctx, client, superSite, key)
+			},
+			func(ctx context.Context, client *base.Client, _ string, body interface{}) (interface{}, error) {
+				b, _ := body.(*S)
+				return base.UpdateSetting(ctx, client, superSite, key, b)
+			},
+		),
+	}
+}
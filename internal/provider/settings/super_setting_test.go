@@ -0,0 +1,107 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuperSettingModel_Site(t *testing.T) {
+	t.Parallel()
+
+	model := superSettingModel{}
+	model.SetSite("other")
+
+	assert.Equal(t, "default", model.GetSite())
+	assert.Equal(t, types.StringValue("default"), model.GetRawSite())
+}
+
+func TestSuperSmtpModel_AsUnifiModel(t *testing.T) {
+	t.Parallel()
+
+	model := superSmtpModel{
+		Enabled:  types.BoolValue(true),
+		Host:     types.StringValue("smtp.example.com"),
+		Port:     types.Int32Value(587),
+		UseSSL:   types.BoolValue(true),
+		Username: types.StringNull(),
+		Password: types.StringNull(),
+		Sender:   types.StringValue("unifi@example.com"),
+	}
+
+	unifiModel, diags := model.AsUnifiModel(context.Background())
+	require.False(t, diags.HasError())
+	smtp, ok := unifiModel.(*superSmtp)
+	require.True(t, ok)
+
+	assert.Equal(t, "super_smtp", smtp.Key)
+	assert.Equal(t, "smtp.example.com", smtp.Host)
+	assert.Equal(t, 587, smtp.Port)
+	assert.False(t, smtp.UseAuth)
+	assert.True(t, smtp.UseSender)
+	assert.Equal(t, "unifi@example.com", smtp.Sender)
+}
+
+func TestSuperSmtpModel_Merge(t *testing.T) {
+	t.Parallel()
+
+	model := superSmtpModel{Password: types.StringValue("secret")}
+	diags := model.Merge(context.Background(), &superSmtp{
+		ID:        "id",
+		XPassword: "secret",
+		Enabled:   true,
+		Host:      "smtp.example.com",
+		Port:      465,
+		UseAuth:   true,
+		Username:  "user",
+		UseSender: false,
+		Sender:    "old@example.com",
+	})
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "id", model.ID.ValueString())
+	assert.Equal(t, "user", model.Username.ValueString())
+	// The write-only password is never kept in the state.
+	assert.True(t, model.Password.IsNull())
+	// The sender is ignored by the controller when not used.
+	assert.True(t, model.Sender.IsNull())
+
+	diags = model.Merge(context.Background(), &superSmtp{ID: "id", Host: "smtp.example.com"})
+	require.False(t, diags.HasError())
+	assert.True(t, model.Username.IsNull())
+	assert.True(t, model.Password.IsNull())
+}
+
+func TestSuperFwupdateModel_AsUnifiModel(t *testing.T) {
+	t.Parallel()
+
+	model := superFwupdateModel{
+		ControllerChannel: types.StringValue("release"),
+		FirmwareChannel:   types.StringUnknown(),
+		AutoUpdate:        types.BoolUnknown(),
+		AutoUpdateHour:    types.Int32Unknown(),
+	}
+
+	unifiModel, diags := model.AsUnifiModel(context.Background())
+	require.False(t, diags.HasError())
+	fwupdate, ok := unifiModel.(*superFwupdate)
+	require.True(t, ok)
+
+	assert.Equal(t, "release", fwupdate.ControllerChannel)
+	assert.Empty(t, fwupdate.FirmwareChannel)
+	// The auto-update window is not sent unless configured.
+	assert.Nil(t, fwupdate.AutoUpdate)
+	assert.Nil(t, fwupdate.AutoUpdateHour)
+
+	model.AutoUpdate = types.BoolValue(true)
+	model.AutoUpdateHour = types.Int32Value(3)
+	unifiModel, _ = model.AsUnifiModel(context.Background())
+	fwupdate, _ = unifiModel.(*superFwupdate)
+	require.NotNil(t, fwupdate.AutoUpdate)
+	assert.True(t, *fwupdate.AutoUpdate)
+	require.NotNil(t, fwupdate.AutoUpdateHour)
+	assert.Equal(t, 3, *fwupdate.AutoUpdateHour)
+}
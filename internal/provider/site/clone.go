@@ -0,0 +1,246 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+)
+
+// cloneClass is a class of objects unifi_site can copy from a source site.
+type cloneClass struct {
+	Name       string
+	Collection string
+}
+
+// cloneClasses are the classes of objects a site can be cloned with, in the
+// order they are copied, so objects are created before the objects
+// referencing them. Settings are handled separately, as they exist in every
+// site and are updated instead.
+var cloneClasses = []cloneClass{
+	{Name: "user_groups", Collection: "usergroup"},
+	{Name: "firewall_groups", Collection: "firewallgroup"},
+	{Name: "networks", Collection: "networkconf"},
+	{Name: "port_profiles", Collection: "portconf"},
+	{Name: "wlans", Collection: "wlanconf"},
+}
+
+const cloneClassSettings = "settings"
+
+// cloneClassNames returns the names of all classes of objects a site can be
+// cloned with.
+func cloneClassNames() []string {
+	names := make([]string, 0, len(cloneClasses)+1)
+	for _, class := range cloneClasses {
+		names = append(names, class.Name)
+	}
+	return append(names, cloneClassSettings)
+}
+
+// cloneObject is an object of the legacy REST API, kept as decoded JSON so
+// objects of every class can be copied without a dedicated model.
+type cloneObject map[string]interface{}
+
+func (o cloneObject) str(key string) string {
+	s, _ := o[key].(string)
+	return s
+}
+
+// builtIn reports whether the controller creates the object with every site,
+// like the default network or user group. Built-in objects are not copied.
+func (o cloneObject) builtIn() bool {
+	noDelete, _ := o["attr_no_delete"].(bool)
+	return noDelete || o.str("attr_hidden_id") != ""
+}
+
+// sameObject reports whether two built-in objects of different sites are the
+// same object.
+func (o cloneObject) sameObject(other cloneObject) bool {
+	if hidden := o.str("attr_hidden_id"); hidden != "" {
+		return hidden == other.str("attr_hidden_id")
+	}
+	return o.str("name") == other.str("name")
+}
+
+// copyFor returns a copy of the object to be created in, or written to, another
+// site, with the IDs of already copied objects replaced by those of their
+// copies.
+func (o cloneObject) copyFor(ids map[string]string) cloneObject {
+	c := make(cloneObject, len(o))
+	for k, v := range o {
+		switch k {
+		case "_id", "site_id", "attr_hidden_id", "attr_no_delete", "attr_no_edit":
+			continue
+		}
+		c[k] = remapIDs(v, ids)
+	}
+	return c
+}
+
+// remapIDs replaces every string of a decoded JSON value that is the ID of a
+// copied object with the ID of its copy. Object IDs are unique across
+// classes, so no other values are replaced.
+func remapIDs(v interface{}, ids map[string]string) interface{} {
+	switch v := v.(type) {
+	case string:
+		if id, ok := ids[v]; ok {
+			return id
+		}
+		return v
+	case []interface{}:
+		r := make([]interface{}, len(v))
+		for i, e := range v {
+			r[i] = remapIDs(e, ids)
+		}
+		return r
+	case map[string]interface{}:
+		r := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			r[k] = remapIDs(e, ids)
+		}
+		return r
+	default:
+		return v
+	}
+}
+
+// siteCloner copies objects of a source site to a target site.
+type siteCloner struct {
+	client *base.Client
+	source string
+	target string
+	// ids maps IDs of objects of the source site to IDs of the corresponding
+	// objects of the target site.
+	ids map[string]string
+}
+
+// cloneSite copies objects of the given classes from the source site to the
+// freshly created target site.
+func cloneSite(ctx context.Context, c *base.Client, source, target string, classes []string) error {
+	selected := make(map[string]bool, len(classes))
+	for _, class := range classes {
+		selected[class] = true
+	}
+	s := &siteCloner{client: c, source: source, target: target, ids: map[string]string{}}
+	if err := s.mapAPGroups(ctx); err != nil {
+		return err
+	}
+	for _, class := range cloneClasses {
+		if err := s.cloneCollection(ctx, class.Collection, selected[class.Name]); err != nil {
+			return fmt.Errorf("failed to clone %s from site %q: %w", strings.ReplaceAll(class.Name, "_", " "), source, err)
+		}
+	}
+	if selected[cloneClassSettings] {
+		if err := s.cloneSettings(ctx); err != nil {
+			return fmt.Errorf("failed to clone settings from site %q: %w", source, err)
+		}
+	}
+	return nil
+}
+
+// mapAPGroups maps the AP groups of the source site to the AP groups of the
+// target site with the same name, as WLANs reference them. AP groups hold
+// devices of a site, so they are not copied.
+func (s *siteCloner) mapAPGroups(ctx context.Context) error {
+	sourceGroups, err := s.client.ListAPGroup(ctx, s.source)
+	if err != nil {
+		return fmt.Errorf("failed to list AP groups of site %q: %w", s.source, err)
+	}
+	targetGroups, err := s.client.ListAPGroup(ctx, s.target)
+	if err != nil {
+		return fmt.Errorf("failed to list AP groups of site %q: %w", s.target, err)
+	}
+	for _, sg := range sourceGroups {
+		for _, tg := range targetGroups {
+			if (sg.HiddenID != "" && sg.HiddenID == tg.HiddenID) || sg.Name == tg.Name {
+				s.ids[sg.ID] = tg.ID
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// cloneCollection maps the built-in objects of a collection to those of the
+// target site and, when copy is set, creates copies of all other objects.
+// Built-in objects are mapped even when their class is not copied, so copied
+// objects of other classes can reference them.
+func (s *siteCloner) cloneCollection(ctx context.Context, collection string, copyObjects bool) error {
+	objects, err := base.ListRest[cloneObject](ctx, s.client, s.source, collection)
+	if err != nil {
+		return err
+	}
+	targetObjects, err := base.ListRest[cloneObject](ctx, s.client, s.target, collection)
+	if err != nil {
+		return err
+	}
+	for _, o := range objects {
+		if !o.builtIn() {
+			continue
+		}
+		for _, t := range targetObjects {
+			if t.builtIn() && o.sameObject(t) {
+				s.ids[o.str("_id")] = t.str("_id")
+				break
+			}
+		}
+	}
+	if !copyObjects {
+		return nil
+	}
+	for _, o := range objects {
+		if o.builtIn() {
+			continue
+		}
+		body := o.copyFor(s.ids)
+		created, err := base.CreateRest(ctx, s.client, s.target, collection, &body)
+		if err != nil {
+			return fmt.Errorf("failed to copy %q: %w", o.str("name"), err)
+		}
+		s.ids[o.str("_id")] = created.str("_id")
+	}
+	return nil
+}
+
+// cloneSettings overwrites the settings of the target site with those of the
+// source site. Controller-wide settings, whose keys start with `super_`, are
+// left alone.
+func (s *siteCloner) cloneSettings(ctx context.Context) error {
+	settings, err := s.listSettings(ctx, s.source)
+	if err != nil {
+		return err
+	}
+	targetSettings, err := s.listSettings(ctx, s.target)
+	if err != nil {
+		return err
+	}
+	for _, setting := range settings {
+		key := setting.str("key")
+		if key == "" || strings.HasPrefix(key, "super_") {
+			continue
+		}
+		body := setting.copyFor(s.ids)
+		for _, t := range targetSettings {
+			if t.str("key") == key {
+				body["_id"] = t.str("_id")
+				body["site_id"] = t.str("site_id")
+				break
+			}
+		}
+		var resp base.RestResponse[cloneObject]
+		if err := s.client.Do(ctx, http.MethodPut, fmt.Sprintf("s/%s/set/setting/%s", s.target, key), body, &resp); err != nil {
+			return fmt.Errorf("failed to copy setting %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func (s *siteCloner) listSettings(ctx context.Context, site string) ([]cloneObject, error) {
+	var resp base.RestResponse[cloneObject]
+	if err := s.client.Do(ctx, http.MethodGet, fmt.Sprintf("s/%s/get/setting", site), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
@@ -0,0 +1,52 @@
+package site
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneObject_CopyFor(t *testing.T) {
+	var wlan cloneObject
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"_id": "wlan1", "site_id": "site1", "name": "Corp",
+		"networkconf_id": "net1", "usergroup_id": "ug1",
+		"ap_group_ids": ["apg1", "apg2"],
+		"schedule_with_duration": [{"name": "net1"}],
+		"enabled": true, "vlan": 10
+	}`), &wlan))
+
+	c := wlan.copyFor(map[string]string{"net1": "net2", "ug1": "ug2", "apg1": "apg3"})
+
+	assert.NotContains(t, c, "_id")
+	assert.NotContains(t, c, "site_id")
+	assert.Equal(t, "Corp", c["name"])
+	assert.Equal(t, "net2", c["networkconf_id"])
+	assert.Equal(t, "ug2", c["usergroup_id"])
+	assert.Equal(t, []interface{}{"apg3", "apg2"}, c["ap_group_ids"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "net2"}}, c["schedule_with_duration"])
+	assert.Equal(t, true, c["enabled"])
+	assert.InDelta(t, 10, c["vlan"], 0)
+	// The source object is left unchanged.
+	assert.Equal(t, "net1", wlan["networkconf_id"])
+}
+
+func TestCloneObject_BuiltIn(t *testing.T) {
+	defaultNetwork := cloneObject{"_id": "1", "name": "Default", "attr_no_delete": true, "attr_hidden_id": "LAN"}
+	renamedDefaultNetwork := cloneObject{"_id": "2", "name": "LAN", "attr_no_delete": true, "attr_hidden_id": "LAN"}
+	defaultGroup := cloneObject{"_id": "3", "name": "Default", "attr_no_delete": true}
+	network := cloneObject{"_id": "4", "name": "Default"}
+
+	assert.True(t, defaultNetwork.builtIn())
+	assert.True(t, defaultGroup.builtIn())
+	assert.False(t, network.builtIn())
+	assert.True(t, defaultNetwork.sameObject(renamedDefaultNetwork))
+	assert.False(t, defaultNetwork.sameObject(defaultGroup))
+	assert.True(t, defaultGroup.sameObject(network))
+}
+
+func TestCloneClassNames(t *testing.T) {
+	assert.Equal(t, []string{"user_groups", "firewall_groups", "networks", "port_profiles", "wlans", "settings"}, cloneClassNames())
+}
@@ -0,0 +1,178 @@
+package site
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+)
+
+// siteStat is a site as returned by the controller's site statistics
+// endpoint. unifi.Site lacks the role of the current admin and the health
+// summary the device count is derived from.
+type siteStat struct {
+	ID          string `json:"_id"`
+	Name        string `json:"name"`
+	Description string `json:"desc"`
+	Role        string `json:"role"`
+	Health      []struct {
+		Subsystem  string `json:"subsystem"`
+		NumAdopted int    `json:"num_adopted"`
+	} `json:"health"`
+}
+
+// deviceCount returns the number of devices adopted in the site, summed over
+// the subsystems reporting devices.
+func (s siteStat) deviceCount() int {
+	count := 0
+	for _, h := range s.Health {
+		switch h.Subsystem {
+		case "wlan", "lan", "wan":
+			count += h.NumAdopted
+		}
+	}
+	return count
+}
+
+func listSiteStats(ctx context.Context, c *base.Client) ([]siteStat, error) {
+	var resp base.RestResponse[siteStat]
+	if err := c.Do(ctx, http.MethodGet, "stat/sites", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+type siteDatasourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Role        types.String `tfsdk:"role"`
+	DeviceCount types.Int64  `tfsdk:"device_count"`
+}
+
+type sitesDatasourceModel struct {
+	DescriptionRegex types.String           `tfsdk:"description_regex"`
+	Sites            []*siteDatasourceModel `tfsdk:"result"`
+}
+
+var (
+	_ datasource.DataSource              = &sitesDatasource{}
+	_ datasource.DataSourceWithConfigure = &sitesDatasource{}
+	_ base.Resource                      = &sitesDatasource{}
+)
+
+type sitesDatasource struct {
+	base.ControllerVersionValidator
+	base.FeatureValidator
+	client *base.Client
+}
+
+func NewSitesDatasource() datasource.DataSource {
+	return &sitesDatasource{}
+}
+
+func (d *sitesDatasource) SetClient(client *base.Client) {
+	d.client = client
+}
+
+func (d *sitesDatasource) SetVersionValidator(validator base.ControllerVersionValidator) {
+	d.ControllerVersionValidator = validator
+}
+
+func (d *sitesDatasource) SetFeatureValidator(validator base.FeatureValidator) {
+	d.FeatureValidator = validator
+}
+
+func (d *sitesDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	base.ConfigureDatasource(d, req, resp)
+}
+
+func (d *sitesDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sites"
+}
+
+func (d *sitesDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_sites` data source lists the sites of the controller the provider's credentials can access. " +
+			"Use it to apply the same configuration to every site, by passing a site's `name` as the `site` attribute of " +
+			"other resources.",
+		Attributes: map[string]schema.Attribute{
+			"description_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return sites whose description (the display name) matches this regular expression.",
+				Optional:            true,
+			},
+			"result": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching sites.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the site.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The internal name of the site, e.g. `default`, as used by the `site` attribute of resources.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the site, shown as its name in the UniFi UI.",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role of the provider's admin on the site, e.g. `admin` or `readonly`.",
+							Computed:            true,
+						},
+						"device_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of devices adopted in the site.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sitesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state sitesDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var descriptionRegex *regexp.Regexp
+	if expr := state.DescriptionRegex.ValueString(); expr != "" {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid description_regex", err.Error())
+			return
+		}
+		descriptionRegex = re
+	}
+
+	sites, err := listSiteStats(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list sites", err.Error())
+		return
+	}
+
+	state.Sites = []*siteDatasourceModel{}
+	for _, s := range sites {
+		if descriptionRegex != nil && !descriptionRegex.MatchString(s.Description) {
+			continue
+		}
+		state.Sites = append(state.Sites, &siteDatasourceModel{
+			ID:          types.StringValue(s.ID),
+			Name:        types.StringValue(s.Name),
+			Description: types.StringValue(s.Description),
+			Role:        types.StringValue(s.Role),
+			DeviceCount: types.Int64Value(int64(s.deviceCount())),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
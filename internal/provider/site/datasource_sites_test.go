@@ -0,0 +1,27 @@
+package site
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSiteStat_DeviceCount(t *testing.T) {
+	var s siteStat
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"_id": "1", "name": "default", "desc": "Default", "role": "admin",
+		"health": [
+			{"subsystem": "wlan", "num_adopted": 3},
+			{"subsystem": "wan", "num_adopted": 1},
+			{"subsystem": "www"},
+			{"subsystem": "lan", "num_adopted": 2},
+			{"subsystem": "vpn"}
+		]
+	}`), &s))
+
+	assert.Equal(t, "Default", s.Description)
+	assert.Equal(t, 6, s.deviceCount())
+	assert.Equal(t, 0, siteStat{}.deviceCount())
+}
@@ -4,12 +4,15 @@
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/filipowm/go-unifi/unifi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
 )
 
 func ResourceSite() *schema.Resource {
@@ -24,7 +27,11 @@ func ResourceSite() *schema.Resource {
 			"  * Wireless networks (WLANs)\n" +
 			"  * Security policies\n" +
 			"  * Device configurations\n\n" +
-			"A UniFi controller can manage multiple sites, making it ideal for multi-tenant or distributed network deployments.",
+			"A UniFi controller can manage multiple sites, making it ideal for multi-tenant or distributed network deployments.\n\n" +
+			"A new site can be cloned from a template site with `source_site`, copying the selected `clone_objects` when the site is created. " +
+			"References between copied objects, e.g. from a WLAN to its network, are pointed at the copies. Built-in objects, such as the " +
+			"default network, and AP groups are not copied; references to them are pointed at the objects of the same name in the new site. " +
+			"When cloning fails, the new site is deleted again, so the apply can simply be retried.",
 
 		CreateContext: resourceSiteCreate,
 		ReadContext:   resourceSiteRead,
@@ -52,10 +59,35 @@ func ResourceSite() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"source_site": {
+				Description: "The name or description of an existing site to clone when creating this site. Only used when the site is created, " +
+					"changing it later has no effect.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressAfterCreate,
+			},
+			"clone_objects": {
+				Description: "The classes of objects to copy from `source_site`. Can be any of `" + strings.Join(cloneClassNames(), "`, `") + "`. " +
+					"Defaults to all of them. Only used when the site is created, changing it later has no effect.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(cloneClassNames(), false),
+				},
+				RequiredWith:     []string{"source_site"},
+				DiffSuppressFunc: suppressAfterCreate,
+			},
 		},
 	}
 }
 
+// suppressAfterCreate suppresses changes to attributes only used when the site
+// is created.
+func suppressAfterCreate(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
 func resourceSiteImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	c, ok := meta.(*base.Client)
 	if !ok {
@@ -96,6 +128,22 @@ func resourceSiteCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	description, _ := d.Get("description").(string)
 
+	// The source site and classes are resolved first, so a site is not
+	// created only to fail cloning into it.
+	source, _ := d.Get("source_site").(string)
+	classes := cloneClassNames()
+	if source != "" {
+		var err error
+		if source, err = c.ResolveSiteName(source); err != nil {
+			return diag.FromErr(err)
+		}
+		if set, ok := d.Get("clone_objects").(*schema.Set); ok && set.Len() > 0 {
+			if classes, err = utils.SetToStringSlice(set); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	resp, err := c.CreateSite(ctx, description)
 	if err != nil {
 		return diag.FromErr(err)
@@ -103,11 +151,28 @@ func resourceSiteCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	site := resp[0]
 	d.SetId(site.ID)
-
-	return resourceSiteSetResourceData(&site, d)
+	if diags := resourceSiteSetResourceData(c, &site, d); diags.HasError() {
+		return diags
+	}
+
+	if source != "" {
+		if err := cloneSite(ctx, c, source, site.Name, classes); err != nil {
+			// Roll back the partially cloned site, so a failed clone leaves
+			// neither a half-configured site nor a tainted resource behind.
+			if _, deleteErr := c.DeleteSite(ctx, site.ID); deleteErr != nil {
+				return diag.FromErr(fmt.Errorf("%w; the partially cloned site %q could not be deleted, "+
+					"it is kept as a tainted resource: %w", err, site.Name, deleteErr))
+			}
+			d.SetId("")
+			return diag.FromErr(err)
+		}
+	}
+	return nil
 }
 
-func resourceSiteSetResourceData(resp *unifi.Site, d *schema.ResourceData) diag.Diagnostics {
+func resourceSiteSetResourceData(c *base.Client, resp *unifi.Site, d *schema.ResourceData) diag.Diagnostics {
+	// Sites created during the run can be referenced by their description too.
+	c.RegisterSite(resp.Name, resp.Description)
 	if err := d.Set("name", resp.Name); err != nil {
 		return diag.FromErr(err)
 	}
@@ -134,7 +199,7 @@ func resourceSiteRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		return diag.FromErr(err)
 	}
 
-	return resourceSiteSetResourceData(site, d)
+	return resourceSiteSetResourceData(c, site, d)
 }
 
 func resourceSiteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -156,7 +221,7 @@ func resourceSiteUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.FromErr(err)
 	}
 
-	return resourceSiteSetResourceData(&resp[0], d)
+	return resourceSiteSetResourceData(c, &resp[0], d)
 }
 
 func resourceSiteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
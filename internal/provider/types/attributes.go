@@ -1,9 +1,14 @@
 package types
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
 )
 
 // ID generates an attribute definition suitable for the always-present `id` attribute.
@@ -25,12 +30,20 @@ func ID(desc ...string) schema.StringAttribute {
 
 func SiteAttribute(desc ...string) schema.StringAttribute {
 	s := schema.StringAttribute{
-		MarkdownDescription: "The name of the UniFi site where this resource should be applied. If not specified, the default site will be used.",
+		MarkdownDescription: "The name of the UniFi site where this resource should be applied, either the internal site name or its description. If not specified, the default site will be used.",
+		CustomType:          SiteType{},
 		Optional:            true,
 		Computed:            true,
+		Validators: []validator.String{
+			siteValidator{},
+		},
 		PlanModifiers: []planmodifier.String{
 			stringplanmodifier.UseStateForUnknown(),
-			stringplanmodifier.RequiresReplace(),
+			stringplanmodifier.RequiresReplaceIf(
+				requiresReplaceIfOtherSite,
+				"Changing the site to another site requires replacing the resource.",
+				"Changing the site to another site requires replacing the resource.",
+			),
 		},
 	}
 
@@ -39,3 +52,31 @@ func SiteAttribute(desc ...string) schema.StringAttribute {
 	}
 	return s
 }
+
+// requiresReplaceIfOtherSite replaces the resource only when the site changes
+// to another site, not when the configuration refers to the same site by its
+// description. Semantic equality is not applied at plan time, so such a change
+// is planned as an in-place update, after which the configured value is kept.
+func requiresReplaceIfOtherSite(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !utils.SitesEquivalent(req.StateValue.ValueString(), req.PlanValue.ValueString())
+}
+
+// siteValidator rejects site descriptions shared by several sites.
+type siteValidator struct{}
+
+func (v siteValidator) Description(_ context.Context) string {
+	return "value must not be a description shared by several sites"
+}
+
+func (v siteValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v siteValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if !IsDefined(req.ConfigValue) {
+		return
+	}
+	if _, err := utils.ResolveSiteName(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Ambiguous site", err.Error())
+	}
+}
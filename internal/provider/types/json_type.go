@@ -0,0 +1,113 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// JSONType is a custom string type for JSON documents. Two values are treated as
+// semantically equal when they decode to the same document regardless of
+// whitespace and the order of object keys, so a document returned by the
+// controller in its own formatting does not produce a diff against the
+// configured one, which is preserved verbatim in state.
+type JSONType struct {
+	basetypes.StringType
+}
+
+var _ basetypes.StringTypable = JSONType{}
+
+func (t JSONType) Equal(o attr.Type) bool {
+	other, ok := o.(JSONType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t JSONType) String() string {
+	return "types.JSONType"
+}
+
+func (t JSONType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return JSONValue{StringValue: in}, nil
+}
+
+func (t JSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t JSONType) ValueType(_ context.Context) attr.Value {
+	return JSONValue{}
+}
+
+// JSONValue is the value type produced by JSONType.
+type JSONValue struct {
+	basetypes.StringValue
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = JSONValue{}
+
+// NewJSONValue returns a known JSONValue holding the given document.
+func NewJSONValue(value string) JSONValue {
+	return JSONValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v JSONValue) Type(_ context.Context) attr.Type {
+	return JSONType{}
+}
+
+func (v JSONValue) Equal(o attr.Value) bool {
+	other, ok := o.(JSONValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true when both values decode to the same JSON
+// document. Null/unknown values and invalid documents fall back to strict
+// equality since their content cannot be compared.
+func (v JSONValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("expected value type %T but got %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	if v.IsNull() || v.IsUnknown() || newValue.IsNull() || newValue.IsUnknown() {
+		return v.StringValue.Equal(newValue.StringValue), diags
+	}
+
+	var a, b interface{}
+	if json.Unmarshal([]byte(v.ValueString()), &a) != nil || json.Unmarshal([]byte(newValue.ValueString()), &b) != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+	return reflect.DeepEqual(a, b), diags
+}
@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+)
+
+func TestJSONValueStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		a    ut.JSONValue
+		b    basetypes.StringValuable
+		want bool
+	}{
+		"identical": {
+			a:    ut.NewJSONValue(`{"enabled":true}`),
+			b:    ut.NewJSONValue(`{"enabled":true}`),
+			want: true,
+		},
+		"whitespace differs": {
+			a:    ut.NewJSONValue("{\n  \"enabled\": true\n}"),
+			b:    ut.NewJSONValue(`{"enabled":true}`),
+			want: true,
+		},
+		"key order differs": {
+			a:    ut.NewJSONValue(`{"a":1,"b":[1,2]}`),
+			b:    ut.NewJSONValue(`{"b":[1,2],"a":1}`),
+			want: true,
+		},
+		"array order differs": {
+			a:    ut.NewJSONValue(`{"b":[1,2]}`),
+			b:    ut.NewJSONValue(`{"b":[2,1]}`),
+			want: false,
+		},
+		"different values": {
+			a:    ut.NewJSONValue(`{"enabled":true}`),
+			b:    ut.NewJSONValue(`{"enabled":false}`),
+			want: false,
+		},
+		"invalid document": {
+			a:    ut.NewJSONValue(`{"enabled":`),
+			b:    ut.NewJSONValue(`{"enabled": `),
+			want: false,
+		},
+		"both null": {
+			a:    ut.JSONValue{StringValue: types.StringNull()},
+			b:    ut.JSONValue{StringValue: types.StringNull()},
+			want: true,
+		},
+		"null vs value": {
+			a:    ut.JSONValue{StringValue: types.StringNull()},
+			b:    ut.NewJSONValue(`{}`),
+			want: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, diags := test.a.StringSemanticEquals(context.Background(), test.b)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != test.want {
+				t.Fatalf("StringSemanticEquals(%s, %s) = %v, want %v", test.a, test.b, got, test.want)
+			}
+		})
+	}
+}
+
+func TestJSONTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	got, err := ut.JSONType{}.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.String, `{ "a": 1 }`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	jsonVal, ok := got.(ut.JSONValue)
+	if !ok {
+		t.Fatalf("expected JSONValue, got %T", got)
+	}
+	if jsonVal.ValueString() != `{ "a": 1 }` {
+		t.Fatalf("value not preserved verbatim: %q", jsonVal.ValueString())
+	}
+}
@@ -7,6 +7,7 @@
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
@@ -20,6 +21,14 @@ func EmptyList(elementType attr.Type) types.List {
 	return types.ListValueMust(elementType, []attr.Value{})
 }
 
+func DefaultEmptySet(elementType attr.Type) defaults.Set {
+	return setdefault.StaticValue(EmptySet(elementType))
+}
+
+func EmptySet(elementType attr.Type) types.Set {
+	return types.SetValueMust(elementType, []attr.Value{})
+}
+
 func ListElementsAs(ctx context.Context, list types.List, target interface{}) diag.Diagnostics {
 	diags := diag.Diagnostics{}
 	if !IsDefined(list) {
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
+)
+
+// SiteType is a custom string type for the `site` attribute. Two values are
+// treated as semantically equal when they refer to the same site, either by its
+// internal name or by its description (e.g. "default" == "Default").
+//
+// Resources store the internal site name, while a configured description is
+// kept verbatim in state by semantic equality, as Terraform rejects a planned
+// or applied value differing from a known configuration value. It is the
+// Plugin Framework analogue of the legacy utils.SiteDiffSuppressFunc.
+type SiteType struct {
+	basetypes.StringType
+}
+
+var _ basetypes.StringTypable = SiteType{}
+
+func (t SiteType) Equal(o attr.Type) bool {
+	other, ok := o.(SiteType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t SiteType) String() string {
+	return "types.SiteType"
+}
+
+func (t SiteType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SiteValue{StringValue: in}, nil
+}
+
+func (t SiteType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t SiteType) ValueType(_ context.Context) attr.Value {
+	return SiteValue{}
+}
+
+// SiteValue is the value type produced by SiteType.
+type SiteValue struct {
+	basetypes.StringValue
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = SiteValue{}
+
+// NewSiteValue returns a known SiteValue for the given site.
+func NewSiteValue(site string) SiteValue {
+	return SiteValue{StringValue: basetypes.NewStringValue(site)}
+}
+
+// NewSiteNull returns a null SiteValue.
+func NewSiteNull() SiteValue {
+	return SiteValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v SiteValue) Type(_ context.Context) attr.Type {
+	return SiteType{}
+}
+
+func (v SiteValue) Equal(o attr.Value) bool {
+	other, ok := o.(SiteValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true when both values refer to the same site.
+// Null/unknown values fall back to strict equality since their content cannot
+// be compared.
+func (v SiteValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SiteValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("expected value type %T but got %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	if v.IsNull() || v.IsUnknown() || newValue.IsNull() || newValue.IsUnknown() {
+		return v.StringValue.Equal(newValue.StringValue), diags
+	}
+
+	return utils.SitesEquivalent(v.ValueString(), newValue.ValueString()), diags
+}
@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
+)
+
+func TestSiteValueStringSemanticEquals(t *testing.T) {
+	utils.RegisterSites("https://site-type-test", map[string]string{
+		"default":  "Default",
+		"8fjk2l1z": "Main Office",
+	})
+
+	tests := map[string]struct {
+		a    ut.SiteValue
+		b    basetypes.StringValuable
+		want bool
+	}{
+		"identical name": {
+			a:    ut.NewSiteValue("default"),
+			b:    ut.NewSiteValue("default"),
+			want: true,
+		},
+		"description and name": {
+			a:    ut.NewSiteValue("Main Office"),
+			b:    ut.NewSiteValue("8fjk2l1z"),
+			want: true,
+		},
+		"different sites": {
+			a:    ut.NewSiteValue("Default"),
+			b:    ut.NewSiteValue("8fjk2l1z"),
+			want: false,
+		},
+		"both null": {
+			a:    ut.NewSiteNull(),
+			b:    ut.NewSiteNull(),
+			want: true,
+		},
+		"null vs value": {
+			a:    ut.NewSiteNull(),
+			b:    ut.NewSiteValue("default"),
+			want: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := test.a.StringSemanticEquals(context.Background(), test.b)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != test.want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.a, test.b, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSiteValueStringSemanticEqualsWrongType(t *testing.T) {
+	_, diags := ut.NewSiteValue("default").StringSemanticEquals(context.Background(), types.StringValue("default"))
+	if !diags.HasError() {
+		t.Fatal("expected an error for a value of another type")
+	}
+}
@@ -99,9 +99,9 @@ func dataUserRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.Errorf("unexpected meta type: %T", meta)
 	}
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	mac, _ := d.Get("mac").(string)
 
@@ -53,9 +53,9 @@ func dataUserGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	name, _ := d.Get("name").(string)
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	groups, err := c.ListUserGroup(ctx, site)
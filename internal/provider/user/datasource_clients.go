@@ -0,0 +1,344 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	ut "github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
+)
+
+// activeClient is a connected station as returned by the controller's
+// `stat/sta` endpoint.
+type activeClient struct {
+	ID        string `json:"_id"`
+	MAC       string `json:"mac"`
+	Hostname  string `json:"hostname"`
+	Name      string `json:"name"`
+	IP        string `json:"ip"`
+	Network   string `json:"network"`
+	NetworkID string `json:"network_id"`
+	ESSID     string `json:"essid"`
+	APMAC     string `json:"ap_mac"`
+	SwMAC     string `json:"sw_mac"`
+	SwPort    int    `json:"sw_port"`
+	IsWired   bool   `json:"is_wired"`
+	IsGuest   bool   `json:"is_guest"`
+	OUI       string `json:"oui"`
+	LastSeen  int64  `json:"last_seen"`
+}
+
+// uplinkMAC returns the MAC of the access point or switch the client is
+// connected to.
+func (c activeClient) uplinkMAC() string {
+	if c.IsWired {
+		return c.SwMAC
+	}
+	return c.APMAC
+}
+
+func listActiveClients(ctx context.Context, c *base.Client, site string) ([]activeClient, error) {
+	var resp base.RestResponse[activeClient]
+	if err := c.Do(ctx, http.MethodGet, fmt.Sprintf("s/%s/stat/sta", site), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// clientFilter holds the filters of the unifi_clients data source. Empty
+// fields match every client.
+type clientFilter struct {
+	HostnameRegex *regexp.Regexp
+	NetworkID     string
+	SSID          string
+	OUI           string
+	DeviceMAC     string
+	IsWired       *bool
+	IsGuest       *bool
+}
+
+func (f clientFilter) matches(c activeClient) bool {
+	switch {
+	case f.HostnameRegex != nil && !f.HostnameRegex.MatchString(c.Hostname):
+		return false
+	case f.NetworkID != "" && c.NetworkID != f.NetworkID:
+		return false
+	case f.SSID != "" && c.ESSID != f.SSID:
+		return false
+	case f.OUI != "" && !strings.EqualFold(c.OUI, f.OUI):
+		return false
+	case f.DeviceMAC != "" && utils.CleanMAC(c.uplinkMAC()) != utils.CleanMAC(f.DeviceMAC):
+		return false
+	case f.IsWired != nil && c.IsWired != *f.IsWired:
+		return false
+	case f.IsGuest != nil && c.IsGuest != *f.IsGuest:
+		return false
+	}
+	return true
+}
+
+type clientDatasourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	MAC        types.String `tfsdk:"mac"`
+	Hostname   types.String `tfsdk:"hostname"`
+	Name       types.String `tfsdk:"name"`
+	IP         types.String `tfsdk:"ip"`
+	Network    types.String `tfsdk:"network"`
+	NetworkID  types.String `tfsdk:"network_id"`
+	SSID       types.String `tfsdk:"ssid"`
+	DeviceMAC  types.String `tfsdk:"device_mac"`
+	SwitchPort types.Int64  `tfsdk:"switch_port"`
+	IsWired    types.Bool   `tfsdk:"is_wired"`
+	IsGuest    types.Bool   `tfsdk:"is_guest"`
+	OUI        types.String `tfsdk:"oui"`
+	LastSeen   types.String `tfsdk:"last_seen"`
+}
+
+func clientModelFrom(c activeClient) *clientDatasourceModel {
+	m := &clientDatasourceModel{
+		ID:         types.StringValue(c.ID),
+		MAC:        types.StringValue(c.MAC),
+		Hostname:   types.StringValue(c.Hostname),
+		Name:       types.StringValue(c.Name),
+		IP:         types.StringValue(c.IP),
+		Network:    types.StringValue(c.Network),
+		NetworkID:  types.StringValue(c.NetworkID),
+		SSID:       types.StringValue(c.ESSID),
+		DeviceMAC:  types.StringValue(c.uplinkMAC()),
+		SwitchPort: types.Int64Null(),
+		IsWired:    types.BoolValue(c.IsWired),
+		IsGuest:    types.BoolValue(c.IsGuest),
+		OUI:        types.StringValue(c.OUI),
+		LastSeen:   types.StringNull(),
+	}
+	if c.IsWired && c.SwPort > 0 {
+		m.SwitchPort = types.Int64Value(int64(c.SwPort))
+	}
+	if c.LastSeen > 0 {
+		m.LastSeen = types.StringValue(time.Unix(c.LastSeen, 0).UTC().Format(time.RFC3339))
+	}
+	return m
+}
+
+type clientsDatasourceModel struct {
+	Site          ut.SiteValue             `tfsdk:"site"`
+	HostnameRegex types.String             `tfsdk:"hostname_regex"`
+	NetworkID     types.String             `tfsdk:"network_id"`
+	SSID          types.String             `tfsdk:"ssid"`
+	OUI           types.String             `tfsdk:"oui"`
+	DeviceMAC     types.String             `tfsdk:"device_mac"`
+	IsWired       types.Bool               `tfsdk:"is_wired"`
+	IsGuest       types.Bool               `tfsdk:"is_guest"`
+	Clients       []*clientDatasourceModel `tfsdk:"result"`
+}
+
+func (m *clientsDatasourceModel) GetSite() string {
+	return m.Site.ValueString()
+}
+
+func (m *clientsDatasourceModel) GetRawSite() types.String {
+	return m.Site.StringValue
+}
+
+func (m *clientsDatasourceModel) SetSite(site string) {
+	m.Site = ut.NewSiteValue(site)
+}
+
+var (
+	_ datasource.DataSource              = &clientsDatasource{}
+	_ datasource.DataSourceWithConfigure = &clientsDatasource{}
+	_ base.Resource                      = &clientsDatasource{}
+)
+
+type clientsDatasource struct {
+	base.ControllerVersionValidator
+	base.FeatureValidator
+	client *base.Client
+}
+
+func NewClientsDatasource() datasource.DataSource {
+	return &clientsDatasource{}
+}
+
+func (d *clientsDatasource) SetClient(client *base.Client) {
+	d.client = client
+}
+
+func (d *clientsDatasource) SetVersionValidator(validator base.ControllerVersionValidator) {
+	d.ControllerVersionValidator = validator
+}
+
+func (d *clientsDatasource) SetFeatureValidator(validator base.FeatureValidator) {
+	d.FeatureValidator = validator
+}
+
+func (d *clientsDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	base.ConfigureDatasource(d, req, resp)
+}
+
+func (d *clientsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clients"
+}
+
+func (d *clientsDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `unifi_clients` data source lists the clients (stations) currently connected to a site, optionally " +
+			"filtered. All filters are combined, a client must match every filter set to be returned. Unlike the `unifi_user` " +
+			"data source, it only returns connected clients, whether or not they are configured on the controller.",
+		Attributes: map[string]schema.Attribute{
+			"site": ut.SiteAttribute(),
+			"hostname_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return clients whose hostname matches this regular expression.",
+				Optional:            true,
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Only return clients connected to the network with this ID.",
+				Optional:            true,
+			},
+			"ssid": schema.StringAttribute{
+				MarkdownDescription: "Only return wireless clients connected to this SSID.",
+				Optional:            true,
+			},
+			"oui": schema.StringAttribute{
+				MarkdownDescription: "Only return clients whose MAC address belongs to this vendor, as resolved by the controller (e.g. `HewlettP`). Matched case-insensitively.",
+				Optional:            true,
+			},
+			"device_mac": schema.StringAttribute{
+				MarkdownDescription: "Only return clients connected to the access point or switch with this MAC address.",
+				Optional:            true,
+			},
+			"is_wired": schema.BoolAttribute{
+				MarkdownDescription: "Only return wired (`true`) or wireless (`false`) clients.",
+				Optional:            true,
+			},
+			"is_guest": schema.BoolAttribute{
+				MarkdownDescription: "Only return guest (`true`) or non-guest (`false`) clients.",
+				Optional:            true,
+			},
+			"result": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching clients.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the client.",
+							Computed:            true,
+						},
+						"mac": schema.StringAttribute{
+							MarkdownDescription: "The MAC address of the client.",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "The hostname the client reported.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the client configured on the controller, if any.",
+							Computed:            true,
+						},
+						"ip": schema.StringAttribute{
+							MarkdownDescription: "The IP address of the client.",
+							Computed:            true,
+						},
+						"network": schema.StringAttribute{
+							MarkdownDescription: "The name of the network the client is connected to.",
+							Computed:            true,
+						},
+						"network_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the network the client is connected to.",
+							Computed:            true,
+						},
+						"ssid": schema.StringAttribute{
+							MarkdownDescription: "The SSID of a wireless client.",
+							Computed:            true,
+						},
+						"device_mac": schema.StringAttribute{
+							MarkdownDescription: "The MAC address of the access point (wireless clients) or switch (wired clients) the client is connected to.",
+							Computed:            true,
+						},
+						"switch_port": schema.Int64Attribute{
+							MarkdownDescription: "The switch port a wired client is connected to.",
+							Computed:            true,
+						},
+						"is_wired": schema.BoolAttribute{
+							MarkdownDescription: "Whether the client is wired.",
+							Computed:            true,
+						},
+						"is_guest": schema.BoolAttribute{
+							MarkdownDescription: "Whether the client is a guest.",
+							Computed:            true,
+						},
+						"oui": schema.StringAttribute{
+							MarkdownDescription: "The vendor of the client's MAC address.",
+							Computed:            true,
+						},
+						"last_seen": schema.StringAttribute{
+							MarkdownDescription: "When the client was last seen, in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *clientsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state clientsDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	site, diags := d.client.ResolveSite(&state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := clientFilter{
+		NetworkID: state.NetworkID.ValueString(),
+		SSID:      state.SSID.ValueString(),
+		OUI:       state.OUI.ValueString(),
+		DeviceMAC: state.DeviceMAC.ValueString(),
+	}
+	if !state.IsWired.IsNull() {
+		isWired := state.IsWired.ValueBool()
+		filter.IsWired = &isWired
+	}
+	if !state.IsGuest.IsNull() {
+		isGuest := state.IsGuest.ValueBool()
+		filter.IsGuest = &isGuest
+	}
+	if hostnameRegex := state.HostnameRegex.ValueString(); hostnameRegex != "" {
+		re, err := regexp.Compile(hostnameRegex)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid hostname_regex", err.Error())
+			return
+		}
+		filter.HostnameRegex = re
+	}
+
+	clients, err := listActiveClients(ctx, d.client, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list clients", err.Error())
+		return
+	}
+
+	state.Clients = []*clientDatasourceModel{}
+	for _, c := range clients {
+		if filter.matches(c) {
+			state.Clients = append(state.Clients, clientModelFrom(c))
+		}
+	}
+	state.SetSite(site)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,65 @@
+package user
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testActiveClients(t *testing.T) []activeClient {
+	t.Helper()
+	var clients []activeClient
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"_id": "1", "mac": "00:00:00:00:00:01", "hostname": "NPI1A2B3C", "ip": "10.0.0.10", "network_id": "lan",
+		 "is_wired": true, "sw_mac": "AA:AA:AA:AA:AA:01", "sw_port": 7, "oui": "HewlettP", "last_seen": 1700000000},
+		{"_id": "2", "mac": "00:00:00:00:00:02", "hostname": "laptop", "ip": "10.0.0.11", "network_id": "lan",
+		 "is_wired": false, "ap_mac": "aa:aa:aa:aa:aa:02", "essid": "office", "oui": "Apple"},
+		{"_id": "3", "mac": "00:00:00:00:00:03", "hostname": "phone", "ip": "10.1.0.5", "network_id": "guest",
+		 "is_wired": false, "is_guest": true, "ap_mac": "aa:aa:aa:aa:aa:02", "essid": "visitors", "oui": "Apple"}
+	]`), &clients))
+	return clients
+}
+
+func filteredClientIDs(clients []activeClient, f clientFilter) []string {
+	var ids []string
+	for _, c := range clients {
+		if f.matches(c) {
+			ids = append(ids, c.ID)
+		}
+	}
+	return ids
+}
+
+func TestClientFilter_Matches(t *testing.T) {
+	clients := testActiveClients(t)
+	wired, guest := true, false
+
+	assert.Equal(t, []string{"1", "2", "3"}, filteredClientIDs(clients, clientFilter{}))
+	assert.Equal(t, []string{"1"}, filteredClientIDs(clients, clientFilter{IsWired: &wired}))
+	assert.Equal(t, []string{"1", "2"}, filteredClientIDs(clients, clientFilter{IsGuest: &guest}))
+	assert.Equal(t, []string{"3"}, filteredClientIDs(clients, clientFilter{NetworkID: "guest"}))
+	assert.Equal(t, []string{"2"}, filteredClientIDs(clients, clientFilter{SSID: "office"}))
+	assert.Equal(t, []string{"2", "3"}, filteredClientIDs(clients, clientFilter{OUI: "apple"}))
+	assert.Equal(t, []string{"1"}, filteredClientIDs(clients, clientFilter{HostnameRegex: regexp.MustCompile("^NPI")}))
+	assert.Equal(t, []string{"1"}, filteredClientIDs(clients, clientFilter{DeviceMAC: "aa:aa:aa:aa:aa:01"}))
+	assert.Equal(t, []string{"2", "3"}, filteredClientIDs(clients, clientFilter{DeviceMAC: "AA-AA-AA-AA-AA-02"}))
+	assert.Equal(t, []string{"2"}, filteredClientIDs(clients, clientFilter{DeviceMAC: "aa:aa:aa:aa:aa:02", IsGuest: &guest}))
+}
+
+func TestClientModelFrom(t *testing.T) {
+	clients := testActiveClients(t)
+
+	wired := clientModelFrom(clients[0])
+	assert.Equal(t, "AA:AA:AA:AA:AA:01", wired.DeviceMAC.ValueString())
+	assert.Equal(t, int64(7), wired.SwitchPort.ValueInt64())
+	assert.Equal(t, "2023-11-14T22:13:20Z", wired.LastSeen.ValueString())
+
+	wireless := clientModelFrom(clients[1])
+	assert.Equal(t, "aa:aa:aa:aa:aa:02", wireless.DeviceMAC.ValueString())
+	assert.Equal(t, "office", wireless.SSID.ValueString())
+	assert.True(t, wireless.SwitchPort.IsNull())
+	assert.True(t, wireless.LastSeen.IsNull())
+}
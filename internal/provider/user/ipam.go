@@ -0,0 +1,122 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/base"
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/utils"
+)
+
+// customizeUserFixedIP checks a fixed IP at plan time against the controller:
+// it must be within the subnet of the client's network, outside of its DHCP
+// range, and not the fixed IP of another client. The controller accepts such
+// addresses, which then only show up as outages. Values not known at plan time
+// are skipped, and the controller is only asked when the fixed IP or network
+// changes. A network that does not exist cannot be checked; as CustomizeDiff
+// cannot return warnings, it is only logged here and reported as a warning by
+// fixedIPNetworkWarnings on apply.
+func customizeUserFixedIP(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	fixedIP, _ := d.Get("fixed_ip").(string)
+	if !d.NewValueKnown("fixed_ip") || fixedIP == "" {
+		return nil
+	}
+	c, ok := meta.(*base.Client)
+	if !ok || c == nil || (d.Id() != "" && !d.HasChange("fixed_ip") && !d.HasChange("network_id")) {
+		return nil
+	}
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return err
+	}
+
+	networkID, _ := d.Get("network_id").(string)
+	if d.NewValueKnown("network_id") && networkID != "" {
+		network, err := c.GetNetwork(ctx, site, networkID)
+		if errors.Is(err, unifi.ErrNotFound) {
+			tflog.Warn(ctx, "network of the client not found, its fixed IP is not checked against the network", map[string]interface{}{
+				"network_id": networkID,
+				"fixed_ip":   fixedIP,
+			})
+		} else if err != nil {
+			return fmt.Errorf("unable to read network %s to check the fixed IP: %w", networkID, err)
+		}
+		if network != nil {
+			if err := validateFixedIPInNetwork(fixedIP, network); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !d.NewValueKnown("mac") {
+		return nil
+	}
+	mac, _ := d.Get("mac").(string)
+	users, err := c.ListUser(ctx, site)
+	if err != nil {
+		return fmt.Errorf("unable to list clients to check the fixed IP: %w", err)
+	}
+	return validateFixedIPUnused(mac, fixedIP, users)
+}
+
+// fixedIPNetworkWarnings warns when the network of a client with a fixed IP
+// does not exist, so the fixed IP could not be checked against its subnet and
+// DHCP range.
+func fixedIPNetworkWarnings(ctx context.Context, c *base.Client, site string, d *schema.ResourceData) diag.Diagnostics {
+	fixedIP, _ := d.Get("fixed_ip").(string)
+	networkID, _ := d.Get("network_id").(string)
+	if fixedIP == "" || networkID == "" {
+		return nil
+	}
+	if _, err := c.GetNetwork(ctx, site, networkID); !errors.Is(err, unifi.ErrNotFound) {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity:      diag.Warning,
+		Summary:       "Network not found",
+		Detail:        fmt.Sprintf("Network %s of the client was not found, so fixed IP %s was not checked against its subnet and DHCP range.", networkID, fixedIP),
+		AttributePath: cty.GetAttrPath("network_id"),
+	}}
+}
+
+// validateFixedIPInNetwork checks that the fixed IP is within the subnet of the
+// network and, when the network runs a DHCP server, outside of its DHCP range,
+// where the address could be leased to another client.
+func validateFixedIPInNetwork(fixedIP string, network *unifi.Network) error {
+	if network.IPSubnet == "" {
+		return nil
+	}
+	inSubnet, err := utils.CidrContainsIP(network.IPSubnet, fixedIP)
+	if err != nil {
+		return nil
+	}
+	if !inSubnet {
+		return fmt.Errorf("fixed IP %s is not within subnet %s of network %q", fixedIP, utils.CidrZeroBased(network.IPSubnet), network.Name)
+	}
+	if network.DHCPDEnabled && utils.IPInRange(fixedIP, network.DHCPDStart, network.DHCPDStop) {
+		return fmt.Errorf("fixed IP %s is within DHCP range %s - %s of network %q", fixedIP, network.DHCPDStart, network.DHCPDStop, network.Name)
+	}
+	return nil
+}
+
+// validateFixedIPUnused checks that no other client has the fixed IP.
+func validateFixedIPUnused(mac, fixedIP string, users []unifi.User) error {
+	for _, u := range users {
+		if u.UseFixedIP && u.FixedIP == fixedIP && !strings.EqualFold(u.MAC, mac) {
+			name := u.Name
+			if name == "" {
+				name = u.MAC
+			}
+			return fmt.Errorf("fixed IP %s is already assigned to client %q", fixedIP, name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFixedIPInNetwork(t *testing.T) {
+	network := &unifi.Network{
+		Name:         "LAN",
+		IPSubnet:     "10.0.0.1/24",
+		DHCPDEnabled: true,
+		DHCPDStart:   "10.0.0.100",
+		DHCPDStop:    "10.0.0.200",
+	}
+
+	tests := []struct {
+		name    string
+		fixedIP string
+		network *unifi.Network
+		wantErr string
+	}{
+		{"outside DHCP range", "10.0.0.50", network, ""},
+		{"outside subnet", "10.0.1.50", network, `fixed IP 10.0.1.50 is not within subnet 10.0.0.0/24 of network "LAN"`},
+		{"within DHCP range", "10.0.0.150", network, `fixed IP 10.0.0.150 is within DHCP range 10.0.0.100 - 10.0.0.200 of network "LAN"`},
+		{"DHCP disabled", "10.0.0.150", &unifi.Network{Name: "LAN", IPSubnet: "10.0.0.1/24", DHCPDStart: "10.0.0.100", DHCPDStop: "10.0.0.200"}, ""},
+		{"network without subnet", "10.0.1.50", &unifi.Network{Name: "VLAN"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFixedIPInNetwork(tt.fixedIP, tt.network)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateFixedIPUnused(t *testing.T) {
+	users := []unifi.User{
+		{MAC: "00:11:22:aa:bb:cc", Name: "printer", UseFixedIP: true, FixedIP: "10.0.0.50"},
+		{MAC: "00:11:22:33:44:66", UseFixedIP: true, FixedIP: "10.0.0.51"},
+		{MAC: "00:11:22:33:44:77", UseFixedIP: false, FixedIP: "10.0.0.52"},
+	}
+
+	tests := []struct {
+		name    string
+		mac     string
+		fixedIP string
+		wantErr string
+	}{
+		{"unused", "00:11:22:33:44:99", "10.0.0.60", ""},
+		{"own fixed IP", "00:11:22:aa:bb:cc", "10.0.0.50", ""},
+		{"own fixed IP other case", "00:11:22:AA:BB:CC", "10.0.0.50", ""},
+		{"used by named client", "00:11:22:33:44:99", "10.0.0.50", `fixed IP 10.0.0.50 is already assigned to client "printer"`},
+		{"used by unnamed client", "00:11:22:33:44:99", "10.0.0.51", `fixed IP 10.0.0.51 is already assigned to client "00:11:22:33:44:66"`},
+		{"stale fixed IP", "00:11:22:33:44:99", "10.0.0.52", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFixedIPUnused(tt.mac, tt.fixedIP, users)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
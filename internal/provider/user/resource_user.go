@@ -41,6 +41,7 @@ func ResourceUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: base.ImportSiteAndID,
 		},
+		CustomizeDiff: customizeUserFixedIP,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -49,11 +50,12 @@ func ResourceUser() *schema.Resource {
 				Computed:    true,
 			},
 			"site": {
-				Description: "The name of the UniFi site where this user should be managed. If not specified, the default site will be used.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				ForceNew:    true,
+				Description:      "The name of the UniFi site where this user should be managed. If not specified, the default site will be used.",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: utils.SiteDiffSuppressFunc,
 			},
 			"mac": {
 				Description: "The MAC address of the device/client. This is used as the unique identifier and cannot be changed " +
@@ -84,8 +86,8 @@ func ResourceUser() *schema.Resource {
 			},
 			// TODO: combine this with output IP for a single attribute ip_address?
 			"fixed_ip": {
-				Description: "A static IPv4 address to assign to this client. Ensure this IP is within the client's network range " +
-					"and not already assigned to another device.",
+				Description: "A static IPv4 address to assign to this client. It must be within the subnet of the client's network " +
+					"(`network_id`), outside of its DHCP range, and not already assigned to another client; this is checked at plan time. When `network_id` refers to a network that does not exist, only a warning is reported.",
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.IsIPv4Address,
@@ -158,9 +160,9 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	allowExisting, _ := d.Get("allow_existing").(bool)
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.CreateUser(ctx, site, req)
@@ -217,7 +219,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		resp.DevIdOverride = device
 	}
 
-	return resourceUserSetResourceData(resp, d, site)
+	return append(resourceUserSetResourceData(resp, d, site), fixedIPNetworkWarnings(ctx, c, site, d)...)
 }
 
 func resourceUserGetResourceData(d *schema.ResourceData) *unifi.User {
@@ -306,9 +308,9 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.GetUser(ctx, site, id)
@@ -343,9 +345,9 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.Errorf("unexpected meta type: %T", meta)
 	}
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("blocked") {
@@ -397,7 +399,11 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		return nil
 	}
 
-	return resourceUserSetResourceData(resp, d, site)
+	diags := resourceUserSetResourceData(resp, d, site)
+	if d.HasChanges("fixed_ip", "network_id") {
+		diags = append(diags, fixedIPNetworkWarnings(ctx, c, site, d)...)
+	}
+	return diags
 }
 
 func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -412,9 +418,9 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interf
 		return nil
 	}
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	// lookup MAC instead of trusting state
@@ -44,11 +44,12 @@ func ResourceUserGroup() *schema.Resource {
 				Computed:    true,
 			},
 			"site": {
-				Description: "The name of the UniFi site where this user group should be created. If not specified, the default site will be used.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				ForceNew:    true,
+				Description:      "The name of the UniFi site where this user group should be created. If not specified, the default site will be used.",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: utils.SiteDiffSuppressFunc,
 			},
 			"name": {
 				Description: "A descriptive name for the user group (e.g., 'Staff', 'Guests', 'IoT Devices'). This name will be " +
@@ -84,9 +85,9 @@ func resourceUserGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	req := resourceUserGroupGetResourceData(d)
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.CreateUserGroup(ctx, site, req)
@@ -133,9 +134,9 @@ func resourceUserGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, err := c.GetUserGroup(ctx, site, id)
@@ -160,9 +161,9 @@ func resourceUserGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 	req.ID = d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	req.SiteID = site
 
@@ -192,11 +193,11 @@ func resourceUserGroupDelete(ctx context.Context, d *schema.ResourceData, meta i
 
 	id := d.Id()
 
-	site, _ := d.Get("site").(string)
-	if site == "" {
-		site = c.Site
+	site, err := c.SiteFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	err := c.DeleteUserGroup(ctx, site, id)
+	err = c.DeleteUserGroup(ctx, site, id)
 	if errors.Is(err, unifi.ErrNotFound) {
 		return nil
 	}
@@ -1,10 +1,12 @@
 package utils
 
 import (
+	"bytes"
 	"fmt"
 	"net"
 	"strings"
 
+	"github.com/apparentlymart/go-cidr/cidr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -70,6 +72,100 @@ func CidrDiffSuppress(k, old, newValue string, d *schema.ResourceData) bool {
 	return oldNet.String() == newNet.String()
 }
 
+// CidrsOverlap reports whether two CIDRs share at least one address. As CIDRs
+// are aligned blocks, they overlap only if one contains the other.
+func CidrsOverlap(a, b string) (bool, error) {
+	_, aNet, err := net.ParseCIDR(a)
+	if err != nil {
+		return false, err
+	}
+	_, bNet, err := net.ParseCIDR(b)
+	if err != nil {
+		return false, err
+	}
+	return aNet.Contains(bNet.IP) || bNet.Contains(aNet.IP), nil
+}
+
+// CidrContainsIP reports whether the address is within the CIDR.
+func CidrContainsIP(cidr, address string) (bool, error) {
+	_, cidrNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false, err
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false, fmt.Errorf("invalid IP address: %s", address)
+	}
+	return cidrNet.Contains(ip), nil
+}
+
+// CidrBroadcast returns the last address of an IPv4 CIDR, or nil if it is not
+// a valid IPv4 CIDR.
+func CidrBroadcast(cidr string) net.IP {
+	_, cidrNet, err := net.ParseCIDR(cidr)
+	if err != nil || cidrNet.IP.To4() == nil {
+		return nil
+	}
+	broadcast := make(net.IP, net.IPv4len)
+	for i, b := range cidrNet.IP.To4() {
+		broadcast[i] = b | ^cidrNet.Mask[i]
+	}
+	return broadcast
+}
+
+// CompareIPs compares two addresses of the same family, returning -1, 0 or 1.
+// Addresses that do not parse compare as equal.
+func CompareIPs(a, b string) int {
+	aIP, bIP := net.ParseIP(a), net.ParseIP(b)
+	if aIP == nil || bIP == nil {
+		return 0
+	}
+	return bytes.Compare(aIP.To16(), bIP.To16())
+}
+
+// IPInRange reports whether the address is between start and stop, inclusive.
+func IPInRange(address, start, stop string) bool {
+	if net.ParseIP(address) == nil || net.ParseIP(start) == nil || net.ParseIP(stop) == nil {
+		return false
+	}
+	return CompareIPs(address, start) >= 0 && CompareIPs(address, stop) <= 0
+}
+
+// NextFreeSubnet returns the first subnet of the given prefix length within
+// the supernet that does not overlap with any of the used CIDRs. Used CIDRs
+// that do not parse are ignored.
+func NextFreeSubnet(supernet string, prefixLength int, used []string) (string, error) {
+	_, superNet, err := net.ParseCIDR(supernet)
+	if err != nil {
+		return "", err
+	}
+	ones, bits := superNet.Mask.Size()
+	if prefixLength < ones || prefixLength > bits {
+		return "", fmt.Errorf("prefix length %d must be between %d and %d for supernet %s", prefixLength, ones, bits, superNet)
+	}
+	newBits := prefixLength - ones
+	if newBits > 24 {
+		return "", fmt.Errorf("prefix length %d is too long for supernet %s", prefixLength, superNet)
+	}
+	for i := 0; i < 1<<newBits; i++ {
+		candidate, err := cidr.Subnet(superNet, newBits, i)
+		if err != nil {
+			return "", err
+		}
+		free := true
+		for _, u := range used {
+			if overlaps, err := CidrsOverlap(candidate.String(), u); err == nil && overlaps {
+				free = false
+				break
+			}
+		}
+		if free {
+			return candidate.String(), nil
+		}
+	}
+	return "", fmt.Errorf("no free /%d subnet left in %s", prefixLength, superNet)
+}
+
 // IsIPv4 checks if the provided address is a valid IPv4 address.
 // It returns true if the address is a valid IPv4 address, false otherwise.
 func IsIPv4(address string) bool {
@@ -35,3 +35,133 @@ func TestCIDRValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestCidrsOverlap(t *testing.T) {
+	for _, c := range []struct {
+		a, b     string
+		expected bool
+	}{
+		{"10.0.0.0/24", "10.0.0.0/24", true},
+		{"10.0.0.1/24", "10.0.0.128/25", true},
+		{"10.0.0.0/16", "10.0.5.0/24", true},
+		{"10.0.0.0/24", "10.0.1.0/24", false},
+		{"192.168.1.0/24", "10.0.0.0/8", false},
+	} {
+		t.Run(c.a+" "+c.b, func(t *testing.T) {
+			actual, err := CidrsOverlap(c.a, c.b)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if actual != c.expected {
+				t.Fatalf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+
+	if _, err := CidrsOverlap("abc", "10.0.0.0/24"); err == nil {
+		t.Fatal("expected an error for an invalid CIDR")
+	}
+}
+
+func TestCidrContainsIP(t *testing.T) {
+	for _, c := range []struct {
+		cidr, address string
+		expected      bool
+	}{
+		{"10.0.0.1/24", "10.0.0.50", true},
+		{"10.0.0.0/24", "10.0.0.255", true},
+		{"10.0.0.0/24", "10.0.1.1", false},
+	} {
+		t.Run(c.cidr+" "+c.address, func(t *testing.T) {
+			actual, err := CidrContainsIP(c.cidr, c.address)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if actual != c.expected {
+				t.Fatalf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+
+	if _, err := CidrContainsIP("10.0.0.0/24", "abc"); err == nil {
+		t.Fatal("expected an error for an invalid address")
+	}
+}
+
+func TestCidrBroadcast(t *testing.T) {
+	for _, c := range []struct {
+		cidr     string
+		expected string
+	}{
+		{"10.0.0.1/24", "10.0.0.255"},
+		{"10.0.0.0/23", "10.0.1.255"},
+		{"192.168.1.5/32", "192.168.1.5"},
+	} {
+		t.Run(c.cidr, func(t *testing.T) {
+			if actual := CidrBroadcast(c.cidr).String(); actual != c.expected {
+				t.Fatalf("expected %s, got %s", c.expected, actual)
+			}
+		})
+	}
+
+	if CidrBroadcast("2001:db8::/64") != nil {
+		t.Fatal("expected no broadcast address for an IPv6 CIDR")
+	}
+}
+
+func TestIPInRange(t *testing.T) {
+	for _, c := range []struct {
+		address, start, stop string
+		expected             bool
+	}{
+		{"10.0.0.6", "10.0.0.6", "10.0.0.254", true},
+		{"10.0.0.254", "10.0.0.6", "10.0.0.254", true},
+		{"10.0.0.100", "10.0.0.6", "10.0.0.254", true},
+		{"10.0.0.5", "10.0.0.6", "10.0.0.254", false},
+		{"10.0.1.10", "10.0.0.6", "10.0.0.254", false},
+		{"abc", "10.0.0.6", "10.0.0.254", false},
+	} {
+		t.Run(c.address, func(t *testing.T) {
+			if actual := IPInRange(c.address, c.start, c.stop); actual != c.expected {
+				t.Fatalf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNextFreeSubnet(t *testing.T) {
+	for _, c := range []struct {
+		name          string
+		supernet      string
+		prefixLength  int
+		used          []string
+		expected      string
+		expectedError string
+	}{
+		{"empty supernet", "10.20.0.0/16", 24, nil, "10.20.0.0/24", ""},
+		{"skips used subnets", "10.20.0.0/16", 24, []string{"10.20.0.1/24", "10.20.1.1/24"}, "10.20.2.0/24", ""},
+		{"reuses gaps", "10.20.0.0/16", 24, []string{"10.20.0.1/24", "10.20.2.1/24"}, "10.20.1.0/24", ""},
+		{"skips containing subnets", "10.20.0.0/16", 24, []string{"10.20.0.0/23"}, "10.20.2.0/24", ""},
+		{"skips contained subnets", "10.20.0.0/16", 24, []string{"10.20.0.128/25"}, "10.20.1.0/24", ""},
+		{"ignores subnets outside", "10.20.0.0/16", 24, []string{"192.168.1.1/24", "invalid"}, "10.20.0.0/24", ""},
+		{"full supernet", "10.20.0.0/23", 24, []string{"10.20.0.1/24", "10.20.1.1/24"}, "", "no free /24 subnet left in 10.20.0.0/23"},
+		{"prefix shorter than supernet", "10.20.0.0/16", 8, nil, "", "prefix length 8 must be between 16 and 32 for supernet 10.20.0.0/16"},
+		{"invalid supernet", "abc", 24, nil, "", "invalid CIDR address: abc"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := NextFreeSubnet(c.supernet, c.prefixLength, c.used)
+			if c.expectedError != "" {
+				if err == nil || err.Error() != c.expectedError {
+					t.Fatalf("expected error %q, got %v", c.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if actual != c.expected {
+				t.Fatalf("expected %s, got %s", c.expected, actual)
+			}
+		})
+	}
+}
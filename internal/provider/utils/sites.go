@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ErrSiteNotFound is returned when a site is neither the internal name nor the
+// description of a site of the controller.
+var ErrSiteNotFound = errors.New("site not found")
+
+var (
+	knownSitesMu sync.RWMutex
+	// knownSites maps the URL of every configured controller to its sites,
+	// each keyed by internal name with its description, the name shown in
+	// the UniFi UI, as value. It is filled when the provider is configured,
+	// so site descriptions can also be resolved where the client is not at
+	// hand, such as in diff suppression and semantic equality. Sites are kept
+	// per controller, as several provider configurations may point to
+	// different controllers whose sites share names or descriptions.
+	knownSites = map[string]map[string]string{}
+)
+
+// RegisterSites records sites of a controller, keyed by internal name with
+// their description as value.
+func RegisterSites(controller string, sites map[string]string) {
+	knownSitesMu.Lock()
+	defer knownSitesMu.Unlock()
+	if knownSites[controller] == nil {
+		knownSites[controller] = make(map[string]string, len(sites))
+	}
+	for name, description := range sites {
+		knownSites[controller][name] = description
+	}
+}
+
+// SitesRegistered reports whether the sites of the controller are known.
+func SitesRegistered(controller string) bool {
+	knownSitesMu.RLock()
+	defer knownSitesMu.RUnlock()
+	_, ok := knownSites[controller]
+	return ok
+}
+
+// resolveSite resolves a site among the sites of a single controller. A
+// description shared by several sites is an error, as it cannot tell which
+// site is meant.
+func resolveSite(sites map[string]string, site string) (string, error) {
+	if _, ok := sites[site]; ok {
+		return site, nil
+	}
+	var names []string
+	for name, description := range sites {
+		if description == site {
+			names = append(names, name)
+		}
+	}
+	switch len(names) {
+	case 0:
+		return "", fmt.Errorf("%w: %q is neither the name nor the description of a site of the controller", ErrSiteNotFound, site)
+	case 1:
+		return names[0], nil
+	default:
+		sort.Strings(names)
+		return "", fmt.Errorf("site %q is ambiguous, it is the description of sites %s; use the internal site name instead", site, strings.Join(names, ", "))
+	}
+}
+
+// ResolveControllerSiteName returns the internal name of a site of the given
+// controller, given either by its internal name or by its description. A site
+// unknown to the controller is an error. When the sites of the controller are
+// not known, e.g. because they could not be listed, the site is returned
+// unchanged, leaving the controller to reject it.
+func ResolveControllerSiteName(controller, site string) (string, error) {
+	if site == "" {
+		return site, nil
+	}
+	knownSitesMu.RLock()
+	defer knownSitesMu.RUnlock()
+	sites, ok := knownSites[controller]
+	if !ok {
+		return site, nil
+	}
+	return resolveSite(sites, site)
+}
+
+// ResolveSiteName returns the internal name of a site given either by its
+// internal name or by its description, without knowing the controller it
+// belongs to. It is used where the client is not at hand. The site is only
+// resolved when every configured controller knowing it resolves it to the
+// same name; otherwise it is returned unchanged and resolved against the
+// controller of the resource later. A description shared by several sites
+// of a controller is an error.
+func ResolveSiteName(site string) (string, error) {
+	if site == "" {
+		return site, nil
+	}
+	knownSitesMu.RLock()
+	defer knownSitesMu.RUnlock()
+	resolved := ""
+	for _, sites := range knownSites {
+		name, err := resolveSite(sites, site)
+		if errors.Is(err, ErrSiteNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if resolved != "" && resolved != name {
+			return site, nil
+		}
+		resolved = name
+	}
+	if resolved == "" {
+		return site, nil
+	}
+	return resolved, nil
+}
+
+// SitesEquivalent reports whether two site values, each an internal name or
+// a description, refer to the same site.
+func SitesEquivalent(a, b string) bool {
+	if a == b {
+		return true
+	}
+	nameA, errA := ResolveSiteName(a)
+	nameB, errB := ResolveSiteName(b)
+	return errA == nil && errB == nil && nameA == nameB
+}
+
+// SiteDiffSuppressFunc suppresses the diff between a site description in the
+// configuration and the internal site name stored in state.
+func SiteDiffSuppressFunc(_, old, newValue string, _ *schema.ResourceData) bool {
+	return SitesEquivalent(old, newValue)
+}
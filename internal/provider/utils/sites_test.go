@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resetKnownSites() {
+	knownSitesMu.Lock()
+	defer knownSitesMu.Unlock()
+	knownSites = map[string]map[string]string{}
+}
+
+func TestResolveSiteName(t *testing.T) {
+	t.Cleanup(resetKnownSites)
+	RegisterSites("https://a", map[string]string{
+		"default":  "Default",
+		"8fjk2l1z": "Main Office",
+		"a1b2c3d4": "Branch",
+		"e5f6g7h8": "Branch",
+	})
+
+	for site, expected := range map[string]string{
+		"":            "",
+		"default":     "default",
+		"Default":     "default",
+		"8fjk2l1z":    "8fjk2l1z",
+		"Main Office": "8fjk2l1z",
+		"unknown":     "unknown",
+	} {
+		name, err := ResolveSiteName(site)
+		require.NoError(t, err, site)
+		assert.Equal(t, expected, name, site)
+	}
+
+	_, err := ResolveSiteName("Branch")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "a1b2c3d4, e5f6g7h8")
+}
+
+func TestResolveControllerSiteName(t *testing.T) {
+	t.Cleanup(resetKnownSites)
+	RegisterSites("https://a", map[string]string{"default": "Default", "8fjk2l1z": "Main Office"})
+	RegisterSites("https://b", map[string]string{"default": "Default", "x9y8z7w6": "Main Office"})
+
+	name, err := ResolveControllerSiteName("https://a", "Main Office")
+	require.NoError(t, err)
+	assert.Equal(t, "8fjk2l1z", name)
+
+	name, err = ResolveControllerSiteName("https://b", "Main Office")
+	require.NoError(t, err)
+	assert.Equal(t, "x9y8z7w6", name)
+
+	_, err = ResolveControllerSiteName("https://a", "unknown")
+	require.ErrorIs(t, err, ErrSiteNotFound)
+
+	name, err = ResolveControllerSiteName("https://unregistered", "unknown")
+	require.NoError(t, err)
+	assert.Equal(t, "unknown", name)
+
+	// The description resolves to different sites on the two controllers,
+	// so it cannot be resolved without knowing the controller.
+	name, err = ResolveSiteName("Main Office")
+	require.NoError(t, err)
+	assert.Equal(t, "Main Office", name)
+	assert.False(t, SitesEquivalent("8fjk2l1z", "Main Office"))
+	assert.True(t, SitesEquivalent("default", "Default"))
+}
+
+func TestSitesEquivalent(t *testing.T) {
+	t.Cleanup(resetKnownSites)
+	RegisterSites("https://a", map[string]string{
+		"8fjk2l1z": "Main Office",
+		"a1b2c3d4": "Branch",
+		"e5f6g7h8": "Branch",
+	})
+
+	assert.True(t, SitesEquivalent("8fjk2l1z", "Main Office"))
+	assert.True(t, SitesEquivalent("Main Office", "8fjk2l1z"))
+	assert.True(t, SitesEquivalent("unknown", "unknown"))
+	assert.False(t, SitesEquivalent("8fjk2l1z", "a1b2c3d4"))
+	assert.False(t, SitesEquivalent("Branch", "a1b2c3d4"))
+	assert.True(t, SiteDiffSuppressFunc("site", "8fjk2l1z", "Main Office", nil))
+}
@@ -0,0 +1,65 @@
+package validators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// JSONObject returns a validator which ensures that the string value is a
+// JSON-encoded object, not containing any of the given reserved keys.
+func JSONObject(reservedKeys ...string) validator.String {
+	return jsonObjectValidator{reservedKeys: reservedKeys}
+}
+
+type jsonObjectValidator struct {
+	reservedKeys []string
+}
+
+func (v jsonObjectValidator) Description(_ context.Context) string {
+	if len(v.reservedKeys) == 0 {
+		return "must be a JSON-encoded object"
+	}
+	return fmt.Sprintf("must be a JSON-encoded object without the keys %s", strings.Join(v.reservedKeys, ", "))
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	value := req.ConfigValue
+	if !types.IsDefined(value) {
+		return
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(value.ValueString()), &object); err != nil || object == nil {
+		resp.Diagnostics.Append(
+			validatordiag.InvalidAttributeValueDiagnostic(
+				req.Path,
+				v.Description(ctx),
+				fmt.Sprintf("%q is not a JSON-encoded object", value.ValueString()),
+			),
+		)
+		return
+	}
+
+	for _, key := range v.reservedKeys {
+		if _, ok := object[key]; ok {
+			resp.Diagnostics.Append(
+				validatordiag.InvalidAttributeValueDiagnostic(
+					req.Path,
+					v.Description(ctx),
+					fmt.Sprintf("key %q is managed by the provider and cannot be set", key),
+				),
+			)
+		}
+	}
+}
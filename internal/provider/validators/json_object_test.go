@@ -0,0 +1,69 @@
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filipowm/terraform-provider-unifi/internal/provider/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONObjectValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid-empty": {
+			val: types.StringValue("{}"),
+		},
+		"valid-object": {
+			val: types.StringValue(`{"enabled": true, "servers": ["10.0.0.1"]}`),
+		},
+		"invalid-syntax": {
+			val:         types.StringValue(`{"enabled": }`),
+			expectError: true,
+		},
+		"invalid-array": {
+			val:         types.StringValue(`[1, 2]`),
+			expectError: true,
+		},
+		"invalid-null": {
+			val:         types.StringValue(`null`),
+			expectError: true,
+		},
+		"invalid-reserved-key": {
+			val:         types.StringValue(`{"key": "ntp"}`),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			validators.JSONObject("_id", "key").ValidateString(context.Background(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}